@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/acehotel33/pokedex-cli/globals"
+	"github.com/acehotel33/pokedex-cli/internal/api"
+	"github.com/acehotel33/pokedex-cli/internal/i18n"
+	"github.com/acehotel33/pokedex-cli/internal/theme"
+)
+
+func commandAbility(conf *globals.Config, params []string) error {
+	if len(params) < 1 {
+		return i18n.Errorf("missing ability")
+	}
+
+	ability, err := api.GetAbility(conf.Endpoint("ability", params[0]), conf)
+	if err != nil {
+		return i18n.Errorf("could not find ability - %w", err)
+	}
+
+	res := abilityResult{
+		msg:         i18n.New(conf.Settings.Lang),
+		Name:        ability.Name,
+		DisplayName: displayName(ability.Names, conf.Settings.Lang, ability.Name),
+		Generation:  ability.Generation.Name,
+		Pokemon:     []abilityHolder{},
+	}
+	for _, entry := range ability.EffectEntries {
+		if entry.Language.Name == "en" {
+			res.Effect, res.ShortEffect = entry.Effect, entry.ShortEffect
+		}
+	}
+	for _, holder := range ability.Pokemon {
+		res.Pokemon = append(res.Pokemon, abilityHolder{Pokemon: holder.Pokemon.Name, Hidden: holder.IsHidden})
+	}
+	return render(conf, res)
+}
+
+type abilityHolder struct {
+	Pokemon string `json:"pokemon"`
+	Hidden  bool   `json:"hidden"`
+}
+
+type abilityResult struct {
+	msg         i18n.Printer
+	Name        string          `json:"name"`
+	DisplayName string          `json:"display_name"`
+	Generation  string          `json:"generation"`
+	Effect      string          `json:"effect"`
+	ShortEffect string          `json:"short_effect"`
+	Pokemon     []abilityHolder `json:"pokemon"`
+}
+
+func (r abilityResult) renderText(w io.Writer, th theme.Theme) {
+	defer fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, ".\n.")
+	fmt.Fprintf(w, "%s %s\n", th.Heading(r.DisplayName), th.Muted("("+r.Name+")"))
+	if r.Generation != "" {
+		fmt.Fprintln(w, r.msg.Sprintf("Introduced in: %s", r.Generation))
+	}
+	if r.ShortEffect != "" {
+		fmt.Fprintln(w, r.msg.Sprintf("Effect: %s", r.ShortEffect))
+	}
+
+	fmt.Fprintln(w, th.Heading(r.msg.Sprintf("Pokemon with this ability:")))
+	for _, holder := range r.Pokemon {
+		if holder.Hidden {
+			fmt.Fprintf(w, "  - %s %s\n", holder.Pokemon, th.Muted(r.msg.Sprintf("(hidden)")))
+		} else {
+			fmt.Fprintf(w, "  - %s\n", holder.Pokemon)
+		}
+	}
+}
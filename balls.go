@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/acehotel33/pokedex-cli/globals"
+	"github.com/acehotel33/pokedex-cli/internal/api"
+	"github.com/acehotel33/pokedex-cli/internal/i18n"
+	"github.com/acehotel33/pokedex-cli/internal/theme"
+)
+
+// pokeBall is a kind of ball the trainer can throw.
+type pokeBall struct {
+	// name is the name of the ball's PokeAPI item.
+	name string
+	// modifier multiplies the chance of a catch.
+	modifier float64
+	// guaranteed balls never fail.
+	guaranteed bool
+	// start is how many of the ball a new trainer carries.
+	start int
+}
+
+var pokeBalls = []pokeBall{
+	{name: "poke-ball", modifier: 1, start: 10},
+	{name: "great-ball", modifier: 1.5, start: 5},
+	{name: "ultra-ball", modifier: 2, start: 3},
+	{name: "master-ball", guaranteed: true, start: 1},
+}
+
+// findBall looks up a ball by its item name, with or without the "-ball"
+// suffix, e.g. "great" or "great-ball".
+func findBall(name string) (pokeBall, error) {
+	name = strings.ToLower(name)
+	if !strings.HasSuffix(name, "-ball") {
+		name += "-ball"
+	}
+	for _, ball := range pokeBalls {
+		if ball.name == name {
+			return ball, nil
+		}
+	}
+
+	names := make([]string, 0, len(pokeBalls))
+	for _, ball := range pokeBalls {
+		names = append(names, strings.TrimSuffix(ball.name, "-ball"))
+	}
+	return pokeBall{}, i18n.Errorf("unknown ball %q - expected one of %s", name, strings.Join(names, ", "))
+}
+
+func startingInventory() map[string]int {
+	inventory := map[string]int{}
+	for _, ball := range pokeBalls {
+		inventory[ball.name] = ball.start
+	}
+	return inventory
+}
+
+func itemShortEffect(item globals.Item) string {
+	for _, entry := range item.EffectEntries {
+		if entry.Language.Name == "en" {
+			return entry.ShortEffect
+		}
+	}
+	return ""
+}
+
+func commandInventory(conf *globals.Config, params []string) error {
+	if len(params) > 0 {
+		if params[0] != "restock" {
+			return i18n.Errorf("unknown argument %q - expected restock", params[0])
+		}
+		conf.Inventory = startingInventory()
+		if err := saveGame(conf); err != nil {
+			return err
+		}
+	}
+
+	res := inventoryResult{msg: i18n.New(conf.Settings.Lang), Items: []inventoryItem{}}
+	for _, ball := range pokeBalls {
+		item, err := api.GetItem(conf.Endpoint("item", ball.name), conf)
+		if err != nil {
+			return i18n.Errorf("could not look up %s - %w", ball.name, err)
+		}
+		res.Items = append(res.Items, inventoryItem{
+			Item:        ball.name,
+			Name:        displayName(item.Names, conf.Settings.Lang, item.Name),
+			Count:       conf.Inventory[ball.name],
+			Description: itemShortEffect(item),
+		})
+	}
+	return render(conf, res)
+}
+
+type inventoryItem struct {
+	Item        string `json:"item"`
+	Name        string `json:"name"`
+	Count       int    `json:"count"`
+	Description string `json:"description"`
+}
+
+type inventoryResult struct {
+	msg   i18n.Printer
+	Items []inventoryItem `json:"items"`
+}
+
+func (r inventoryResult) renderText(w io.Writer, th theme.Theme) {
+	defer fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, th.Heading(r.msg.Sprintf("Bag:")))
+	for _, item := range r.Items {
+		// Pad before colouring, as the colour codes would count as width.
+		count := fmt.Sprintf("%4s", fmt.Sprintf("x%d", item.Count))
+		if item.Count == 0 {
+			count = th.Failure(count)
+		}
+		fmt.Fprintf(w, "  %-12s %s  %s\n", item.Name, count, th.Muted(item.Description))
+	}
+}
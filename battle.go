@@ -0,0 +1,477 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/acehotel33/pokedex-cli/globals"
+	"github.com/acehotel33/pokedex-cli/internal/api"
+	"github.com/acehotel33/pokedex-cli/internal/battle"
+	"github.com/acehotel33/pokedex-cli/internal/i18n"
+	"github.com/acehotel33/pokedex-cli/internal/theme"
+)
+
+// maxMoves is how many moves a pokemon knows at once.
+const maxMoves = 4
+
+func commandLead(conf *globals.Config, params []string) error {
+	if len(params) < 1 {
+		if conf.Lead == "" {
+			return render(conf, messageResult{msg: i18n.New(conf.Settings.Lang), Message: "You have no lead pokemon yet - catch one first."})
+		}
+		return render(conf, leadResult{msg: i18n.New(conf.Settings.Lang), Lead: conf.Lead})
+	}
+
+	if _, exists := conf.Pokedex[params[0]]; !exists {
+		return i18n.Errorf("you have not caught %s", params[0])
+	}
+	conf.Lead = params[0]
+	if err := saveGame(conf); err != nil {
+		return err
+	}
+	return render(conf, leadResult{msg: i18n.New(conf.Settings.Lang), Lead: conf.Lead, Changed: true})
+}
+
+// commandHeal restores every caught pokemon to full health, like a visit to
+// a Pokemon Center.
+func commandHeal(conf *globals.Config, params []string) error {
+	for name, pokemon := range conf.Pokedex {
+		pokemon.HP, pokemon.Status, pokemon.Fainted = 0, "", false
+		conf.Pokedex[name] = pokemon
+	}
+	if err := saveGame(conf); err != nil {
+		return err
+	}
+	return render(conf, messageResult{msg: i18n.New(conf.Settings.Lang), Message: "Your pokemon are fully healed."})
+}
+
+func commandFight(conf *globals.Config, params []string) error {
+	encounter := conf.Encounter
+	if encounter == nil {
+		return i18n.Errorf("there is no wild pokemon here - use 'walk' to look for one")
+	}
+	if len(conf.Pokedex) == 0 {
+		return i18n.Errorf("you have no pokemon to fight with - catch one first")
+	}
+	leadPokemon, exists := conf.Pokedex[conf.Lead]
+	if !exists {
+		return i18n.Errorf("you have no lead pokemon - choose one with 'lead <pokemon>'")
+	}
+	if leadPokemon.Fainted {
+		return i18n.Errorf("%s has fainted - use 'heal' or choose another lead with 'lead <pokemon>'", leadPokemon.Name)
+	}
+
+	// Pokemon caught outside of game mode have no level and fight at the
+	// level of the wild pokemon.
+	leadLevel := leadPokemon.Level
+	if leadLevel == 0 {
+		leadLevel = encounter.Level
+	}
+	lead, err := newCombatant(conf, leadPokemon, leadLevel, leadPokemon.HP)
+	if err != nil {
+		return err
+	}
+	lead.Status = leadPokemon.Status
+
+	wildPokemon, err := api.GetPokemon(conf.Endpoint("pokemon", encounter.Pokemon), conf)
+	if err != nil {
+		return i18n.Errorf("could not find pokemon - %w", err)
+	}
+	wild, err := newCombatant(conf, wildPokemon, encounter.Level, encounter.HP)
+	if err != nil {
+		return err
+	}
+	wild.Status = encounter.Status
+
+	moveTypes := []string{}
+	for _, move := range append(lead.Damaging(), wild.Damaging()...) {
+		moveTypes = append(moveTypes, move.Type)
+	}
+	chart, err := loadTypeChart(conf, moveTypes)
+	if err != nil {
+		return err
+	}
+
+	leadMove := battle.BestMove(lead, wild, chart)
+	if len(params) > 0 {
+		leadMove, err = findMove(lead, params[0])
+		if err != nil {
+			return err
+		}
+	}
+	wildMove := battle.RandomMove(wild, conf.Rand)
+
+	res := battleResult{
+		msg:          i18n.New(conf.Settings.Lang),
+		Lead:         lead.Name,
+		LeadLevel:    lead.Level,
+		Wild:         wild.Name,
+		WildLevel:    wild.Level,
+		Turns:        []battleTurn{},
+		StatusDamage: []statusDamage{},
+	}
+	names := newNameLoader(conf, conf.Settings.Lang)
+	names.load("move", []string{leadMove.Name, wildMove.Name})
+	res.Names, res.Warning = names.names, names.warning()
+	leadTurn := func() {
+		hit := battle.Attack(&lead, &wild, leadMove, chart, conf.Rand)
+		res.Turns = append(res.Turns, battleTurn{Hit: hit, Target: wild.Name})
+	}
+	wildTurn := func() {
+		hit := battle.Attack(&wild, &lead, wildMove, chart, conf.Rand)
+		res.Turns = append(res.Turns, battleTurn{Hit: hit, Target: lead.Name})
+	}
+	if battle.First(lead, wild, conf.Rand) {
+		leadTurn()
+		if !wild.Fainted() {
+			wildTurn()
+		}
+	} else {
+		wildTurn()
+		if !lead.Fainted() {
+			leadTurn()
+		}
+	}
+	// Burns and poison hurt at the end of the turn.
+	for _, c := range []*battle.Combatant{&lead, &wild} {
+		if c.Fainted() {
+			continue
+		}
+		status := c.Status
+		if damage := battle.StatusDamage(c); damage > 0 {
+			res.StatusDamage = append(res.StatusDamage, statusDamage{Pokemon: c.Name, Status: status, Damage: damage})
+		}
+	}
+
+	res.LeadHP, res.LeadMaxHP, res.LeadStatus = lead.HP, lead.Stats.HP, lead.Status
+	res.WildHP, res.WildMaxHP, res.WildStatus = wild.HP, wild.Stats.HP, wild.Status
+	// The lead keeps its wounds until it is healed.
+	leadPokemon.HP, leadPokemon.Status, leadPokemon.Fainted = lead.HP, lead.Status, lead.Fainted()
+	conf.Pokedex[conf.Lead] = leadPokemon
+	switch {
+	case wild.Fainted():
+		res.Outcome = "won"
+		conf.Encounter = nil
+	case lead.Fainted():
+		res.Outcome = "lost"
+		conf.Encounter = nil
+	default:
+		encounter.HP, encounter.MaxHP, encounter.Status = wild.HP, wild.Stats.HP, wild.Status
+	}
+
+	if err := saveGame(conf); err != nil {
+		return err
+	}
+	return render(conf, res)
+}
+
+// newCombatant sends pokemon into battle at level with hp left, or at full
+// health if hp is 0.
+func newCombatant(conf *globals.Config, pokemon globals.Pokemon, level, hp int) (battle.Combatant, error) {
+	moves, err := loadMoves(conf, learnedMoves(pokemon, level))
+	if err != nil {
+		return battle.Combatant{}, i18n.Errorf("could not look up the moves of %s - %w", pokemon.Name, err)
+	}
+
+	c := battle.Combatant{
+		Name:  pokemon.Name,
+		Level: level,
+		Types: pokemonTypes(pokemon),
+		Stats: battle.StatsAt(baseStats(pokemon), level),
+		Moves: moves,
+	}
+	c.HP = c.Stats.HP
+	if hp > 0 {
+		c.HP = min(hp, c.Stats.HP)
+	}
+	return c, nil
+}
+
+func baseStats(pokemon globals.Pokemon) battle.Stats {
+	var stats battle.Stats
+	for _, stat := range pokemon.Stats {
+		switch stat.Stat.Name {
+		case "hp":
+			stats.HP = stat.BaseStat
+		case "attack":
+			stats.Attack = stat.BaseStat
+		case "defense":
+			stats.Defense = stat.BaseStat
+		case "special-attack":
+			stats.SpecialAttack = stat.BaseStat
+		case "special-defense":
+			stats.SpecialDefense = stat.BaseStat
+		case "speed":
+			stats.Speed = stat.BaseStat
+		}
+	}
+	return stats
+}
+
+func pokemonTypes(pokemon globals.Pokemon) []string {
+	types := make([]string, 0, len(pokemon.Types))
+	for _, pType := range pokemon.Types {
+		types = append(types, pType.Type.Name)
+	}
+	return types
+}
+
+// learnedMoves returns the last maxMoves moves pokemon learned by levelling
+// up to level, like a pokemon in the wild knows. A pokemon that learns no
+// moves by level knows its first maxMoves moves.
+func learnedMoves(pokemon globals.Pokemon, level int) []string {
+	type learned struct {
+		name  string
+		level int
+	}
+	moves := []learned{}
+	for _, move := range pokemon.Moves {
+		learnedAt := -1
+		for _, detail := range move.VersionGroupDetails {
+			if detail.MoveLearnMethod.Name != "level-up" || detail.LevelLearnedAt > level {
+				continue
+			}
+			if learnedAt < 0 || detail.LevelLearnedAt < learnedAt {
+				learnedAt = detail.LevelLearnedAt
+			}
+		}
+		if learnedAt >= 0 {
+			moves = append(moves, learned{name: move.Move.Name, level: learnedAt})
+		}
+	}
+	sort.SliceStable(moves, func(i, j int) bool {
+		return moves[i].level < moves[j].level
+	})
+
+	names := []string{}
+	for _, move := range moves[max(len(moves)-maxMoves, 0):] {
+		names = append(names, move.name)
+	}
+	if len(names) == 0 {
+		for _, move := range pokemon.Moves[:min(len(pokemon.Moves), maxMoves)] {
+			names = append(names, move.Move.Name)
+		}
+	}
+	return names
+}
+
+func loadMoves(conf *globals.Config, names []string) ([]battle.Move, error) {
+	moves := []battle.Move{}
+	for _, name := range names {
+		move, err := api.GetMove(conf.Endpoint("move", name), conf)
+		if err != nil {
+			return nil, err
+		}
+		m := battle.Move{
+			Name:        move.Name,
+			Type:        move.Type.Name,
+			DamageClass: move.DamageClass.Name,
+			Power:       move.Power,
+			Accuracy:    move.Accuracy,
+		}
+		// Other ailments, such as confusion, wear off and are left out.
+		if battle.IsStatus(move.Meta.Ailment.Name) {
+			m.Ailment, m.AilmentChance = move.Meta.Ailment.Name, move.Meta.AilmentChance
+		}
+		moves = append(moves, m)
+	}
+	return moves, nil
+}
+
+func findMove(c battle.Combatant, name string) (battle.Move, error) {
+	names := []string{}
+	for _, move := range c.Usable() {
+		if move.Name == name {
+			return move, nil
+		}
+		names = append(names, move.Name)
+	}
+	return battle.Move{}, i18n.Errorf("%s can't attack with %s - it knows %s", c.Name, name, strings.Join(names, ", "))
+}
+
+// loadTypeChart builds the type chart for moves of the given types from the
+// PokeAPI type resources.
+func loadTypeChart(conf *globals.Config, attackTypes []string) (battle.Chart, error) {
+	chart := battle.Chart{}
+	for _, name := range attackTypes {
+		if _, loaded := chart[name]; loaded || name == "" {
+			continue
+		}
+		pokemonType, err := api.GetType(conf.Endpoint("type", name), conf)
+		if err != nil {
+			return nil, i18n.Errorf("could not look up type %s - %w", name, err)
+		}
+		chart[name] = damageTo(pokemonType)
+	}
+	return chart, nil
+}
+
+// damageTo returns the multipliers of moves of type t against the types it
+// is not normally effective against.
+func damageTo(t globals.Type) map[string]float64 {
+	multipliers := map[string]float64{}
+	for _, relation := range t.DamageRelations.DoubleDamageTo {
+		multipliers[relation.Name] = 2
+	}
+	for _, relation := range t.DamageRelations.HalfDamageTo {
+		multipliers[relation.Name] = 0.5
+	}
+	for _, relation := range t.DamageRelations.NoDamageTo {
+		multipliers[relation.Name] = 0
+	}
+	return multipliers
+}
+
+type leadResult struct {
+	msg     i18n.Printer
+	Lead    string `json:"lead"`
+	Changed bool   `json:"changed"`
+}
+
+func (r leadResult) renderText(w io.Writer, th theme.Theme) {
+	defer fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, ".\n.")
+	if r.Changed {
+		fmt.Fprintln(w, r.msg.Sprintf("Go, %s!", th.Heading(r.Lead)))
+		return
+	}
+	fmt.Fprintln(w, r.msg.Sprintf("%s leads your team.", th.Heading(r.Lead)))
+}
+
+// statusMessages tell that a pokemon was given a status condition.
+var statusMessages = map[string]string{
+	"burn":      "%s was burned!",
+	"freeze":    "%s was frozen solid!",
+	"paralysis": "%s is paralyzed!",
+	"poison":    "%s was poisoned!",
+	"sleep":     "%s fell asleep!",
+}
+
+// skippedMessages tell that a status condition kept a pokemon from moving,
+// curedMessages that it recovered from one and damageMessages that one hurt
+// it at the end of the turn.
+var (
+	skippedMessages = map[string]string{
+		"freeze":    "%s is frozen solid!",
+		"paralysis": "%s is paralyzed! It can't move!",
+		"sleep":     "%s is fast asleep.",
+	}
+	curedMessages = map[string]string{
+		"freeze": "%s thawed out!",
+		"sleep":  "%s woke up!",
+	}
+	damageMessages = map[string]string{
+		"burn":   "%s is hurt by its burn and lost %d HP.",
+		"poison": "%s is hurt by poison and lost %d HP.",
+	}
+)
+
+type battleTurn struct {
+	battle.Hit
+	Target string `json:"target"`
+}
+
+type battleResult struct {
+	msg        i18n.Printer
+	Names      localNames   `json:"names,omitempty"`
+	Warning    string       `json:"warning,omitempty"`
+	Lead       string       `json:"lead"`
+	LeadLevel  int          `json:"lead_level"`
+	LeadHP     int          `json:"lead_hp"`
+	LeadMaxHP  int          `json:"lead_max_hp"`
+	LeadStatus string       `json:"lead_status,omitempty"`
+	Wild       string       `json:"wild"`
+	WildLevel  int          `json:"wild_level"`
+	WildHP     int          `json:"wild_hp"`
+	WildMaxHP  int          `json:"wild_max_hp"`
+	WildStatus string       `json:"wild_status,omitempty"`
+	Turns      []battleTurn `json:"turns"`
+	// StatusDamage is what burns and poison did at the end of the turn.
+	StatusDamage []statusDamage `json:"status_damage"`
+	// Outcome is won or lost once either pokemon fainted.
+	Outcome string `json:"outcome,omitempty"`
+}
+
+type statusDamage struct {
+	Pokemon string `json:"pokemon"`
+	Status  string `json:"status"`
+	Damage  int    `json:"damage"`
+}
+
+func (r battleResult) renderText(w io.Writer, th theme.Theme) {
+	defer fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, r.msg.Sprintf("%s (lv %d) vs wild %s (lv %d)", th.Heading(r.Lead), r.LeadLevel, th.Heading(r.Wild), r.WildLevel))
+	renderNameWarning(w, th, r.msg, r.Warning)
+
+	for _, turn := range r.Turns {
+		if turn.Skipped != "" {
+			fmt.Fprintln(w, r.msg.Sprintf(skippedMessages[turn.Skipped], turn.Attacker))
+			continue
+		}
+		sentences := []string{}
+		if turn.Cured != "" {
+			sentences = append(sentences, r.msg.Sprintf(curedMessages[turn.Cured], turn.Attacker))
+		}
+		if turn.Missed {
+			sentences = append(sentences, r.msg.Sprintf("%s used %s, but it missed!", turn.Attacker, r.Names.label(turn.Move, th)))
+			fmt.Fprintln(w, strings.Join(sentences, " "))
+			continue
+		}
+		sentences = append(sentences, r.msg.Sprintf("%s used %s!", turn.Attacker, r.Names.label(turn.Move, th)))
+		switch {
+		case turn.Effectiveness == 0:
+			sentences = append(sentences, r.msg.Sprintf("It doesn't affect %s...", turn.Target))
+			fmt.Fprintln(w, strings.Join(sentences, " "))
+			continue
+		case turn.Failed:
+			sentences = append(sentences, r.msg.Sprintf("But it failed!"))
+			fmt.Fprintln(w, strings.Join(sentences, " "))
+			continue
+		case turn.Damage == 0:
+			// A status move only inflicts its status.
+			sentences = append(sentences, r.msg.Sprintf(statusMessages[turn.Status], turn.Target))
+			fmt.Fprintln(w, strings.Join(sentences, " "))
+			continue
+		}
+		if turn.Critical {
+			sentences = append(sentences, r.msg.Sprintf("A critical hit!"))
+		}
+		if turn.Effectiveness > 1 {
+			sentences = append(sentences, r.msg.Sprintf("It's super effective!"))
+		} else if turn.Effectiveness < 1 {
+			sentences = append(sentences, r.msg.Sprintf("It's not very effective..."))
+		}
+		sentences = append(sentences, r.msg.Sprintf("%s lost %d HP.", turn.Target, turn.Damage))
+		if turn.Status != "" {
+			sentences = append(sentences, r.msg.Sprintf(statusMessages[turn.Status], turn.Target))
+		}
+		fmt.Fprintln(w, strings.Join(sentences, " "))
+	}
+	for _, hurt := range r.StatusDamage {
+		fmt.Fprintln(w, r.msg.Sprintf(damageMessages[hurt.Status], hurt.Pokemon, hurt.Damage))
+	}
+
+	for _, c := range []struct {
+		name      string
+		hp, maxHP int
+		status    string
+	}{{r.Lead, r.LeadHP, r.LeadMaxHP, r.LeadStatus}, {r.Wild, r.WildHP, r.WildMaxHP, r.WildStatus}} {
+		line := fmt.Sprintf("  %-12s %s %3d/%d", c.name, th.Bar(c.hp, c.maxHP, 10), c.hp, c.maxHP)
+		if c.status != "" {
+			line += " " + th.Muted(c.status)
+		}
+		fmt.Fprintln(w, line)
+	}
+
+	switch r.Outcome {
+	case "won":
+		fmt.Fprintln(w, r.msg.Sprintf("The wild %s fainted!", r.Wild), th.Success(r.msg.Sprintf("You won!")))
+	case "lost":
+		fmt.Fprintln(w, r.msg.Sprintf("%s fainted!", r.Lead), th.Failure(r.msg.Sprintf("The wild %s got away.", r.Wild)))
+	default:
+		fmt.Fprintln(w, th.Muted(r.msg.Sprintf("Keep fighting with 'fight [move]' or throw a ball with 'catch'.")))
+	}
+}
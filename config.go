@@ -0,0 +1,128 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/acehotel33/pokedex-cli/globals"
+	"github.com/acehotel33/pokedex-cli/internal/i18n"
+	"github.com/acehotel33/pokedex-cli/internal/settings"
+	"github.com/acehotel33/pokedex-cli/internal/theme"
+)
+
+func commandConfig(conf *globals.Config, params []string) error {
+	if len(params) < 1 {
+		return i18n.Errorf("missing subcommand - expected list, get or set")
+	}
+
+	switch params[0] {
+	case "list":
+		res := configResult{msg: i18n.New(conf.Settings.Lang)}
+		for _, key := range settings.Keys() {
+			val, _ := conf.Settings.Get(key)
+			res.Settings = append(res.Settings, configEntry{
+				Key:         key,
+				Value:       val,
+				Description: settings.Describe(key),
+			})
+		}
+		return render(conf, res)
+
+	case "get":
+		if len(params) < 2 {
+			return i18n.Errorf("missing setting name")
+		}
+		val, err := conf.Settings.Get(params[1])
+		if err != nil {
+			return err
+		}
+		return render(conf, configResult{msg: i18n.New(conf.Settings.Lang), Settings: []configEntry{{Key: params[1], Value: val}}})
+
+	case "set":
+		if len(params) < 3 {
+			return i18n.Errorf("usage: config set <key> <value>")
+		}
+		// Values such as a player command may contain spaces.
+		key, val := params[1], strings.Join(params[2:], " ")
+		if err := conf.Settings.Set(key, val); err != nil {
+			return err
+		}
+		if conf.ConfigPath != "" {
+			// Save only what the file already had plus this change, so that
+			// environment overrides of other settings do not end up in it.
+			fileSettings, err := settings.LoadFile(conf.ConfigPath)
+			if err != nil {
+				return err
+			}
+			if err := fileSettings.Set(key, val); err != nil {
+				return err
+			}
+			if err := settings.Save(conf.ConfigPath, fileSettings); err != nil {
+				return err
+			}
+		}
+
+		res := configResult{msg: i18n.New(conf.Settings.Lang)}
+		newVal, _ := conf.Settings.Get(key)
+		res.Settings = append(res.Settings, configEntry{Key: key, Value: newVal})
+		switch key {
+		case "api_url":
+			// The area index was fetched from the old API.
+			conf.AreaIndex = nil
+			fallthrough
+		case "page_size":
+			conf.MapLimit = conf.Settings.PageSize
+			conf.MapPage = 0
+			conf.MapCount = 0
+		case "color", "theme":
+			conf.Theme = newTheme(conf.Settings, conf.Out)
+		case "cache_ttl":
+			res.Notes = append(res.Notes, res.msg.Sprintf("cache_ttl applies the next time the Pokedex starts"))
+		}
+		if _, ok := os.LookupEnv(settings.EnvVar(key)); ok {
+			res.Notes = append(res.Notes, res.msg.Sprintf("%s is set and overrides this value on the next start", settings.EnvVar(key)))
+		}
+		return render(conf, res)
+
+	default:
+		return i18n.Errorf("unknown subcommand %q - expected list, get or set", params[0])
+	}
+}
+
+type configEntry struct {
+	Key         string `json:"key"`
+	Value       string `json:"value"`
+	Description string `json:"description,omitempty"`
+}
+
+type configResult struct {
+	msg      i18n.Printer
+	Settings []configEntry `json:"settings"`
+	Notes    []string      `json:"notes,omitempty"`
+}
+
+func (r configResult) renderText(w io.Writer, th theme.Theme) {
+	defer fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, ".\n.")
+	for _, entry := range r.Settings {
+		if entry.Description != "" {
+			fmt.Fprintf(w, "%s = %s  (%s)\n", entry.Key, entry.Value, entry.Description)
+		} else {
+			fmt.Fprintf(w, "%s = %s\n", entry.Key, entry.Value)
+		}
+	}
+	for _, note := range r.Notes {
+		fmt.Fprintln(w, r.msg.Sprintf("Note: %s", note))
+	}
+}
+
+// commandLang shows or sets the lang setting, like config get lang and
+// config set lang <code>.
+func commandLang(conf *globals.Config, params []string) error {
+	if len(params) < 1 {
+		return commandConfig(conf, []string{"get", "lang"})
+	}
+	return commandConfig(conf, []string{"set", "lang", params[0]})
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/acehotel33/pokedex-cli/globals"
+	"github.com/acehotel33/pokedex-cli/internal/api"
+	"github.com/acehotel33/pokedex-cli/internal/audio"
+	"github.com/acehotel33/pokedex-cli/internal/i18n"
+	"github.com/acehotel33/pokedex-cli/internal/theme"
+)
+
+func commandCry(conf *globals.Config, params []string) error {
+	fs := flag.NewFlagSet("cry", flag.ContinueOnError)
+	legacy := fs.Bool("legacy", false, "play the cry from the original games")
+	save := fs.String("save", "", "write the cry to this ogg file")
+	args, err := parseFlags(fs, params)
+	if err != nil {
+		return err
+	}
+	if len(args) < 1 {
+		return i18n.Errorf("missing pokemon")
+	}
+
+	player, err := cryPlayer(conf)
+	if err != nil {
+		return i18n.Errorf("invalid cry_player - %w", err)
+	}
+	if player == nil && *save == "" {
+		return i18n.Errorf("no player for cries - set cry_player, e.g. 'config set cry_player ffplay -nodisp -autoexit', or use --save <file>")
+	}
+
+	pokemon, err := api.GetPokemon(conf.Endpoint("pokemon", args[0]), conf)
+	if err != nil {
+		return i18n.Errorf("could not find pokemon - %w", err)
+	}
+	url := pokemon.Cries.Latest
+	if *legacy {
+		url = pokemon.Cries.Legacy
+	}
+	if url == "" && *legacy {
+		return i18n.Errorf("%s has no legacy cry", pokemon.Name)
+	}
+	if url == "" {
+		return i18n.Errorf("%s has no cry", pokemon.Name)
+	}
+	data, err := api.GetCry(url, conf)
+	if err != nil {
+		return i18n.Errorf("could not download cry - %w", err)
+	}
+
+	res := cryResult{msg: i18n.New(conf.Settings.Lang), Pokemon: pokemon.Name, Legacy: *legacy, URL: url}
+	if *save != "" {
+		if err := os.WriteFile(*save, data, 0o644); err != nil {
+			return i18n.Errorf("could not save cry - %w", err)
+		}
+		res.Saved = *save
+	}
+	if player != nil {
+		if err := player.Play(data); err != nil {
+			return err
+		}
+		res.Played = true
+	}
+	return render(conf, res)
+}
+
+// cryPlayer returns the player for cries, or nil if there is none.
+func cryPlayer(conf *globals.Config) (audio.Player, error) {
+	if conf.Player != nil {
+		return conf.Player, nil
+	}
+	if conf.Settings.CryPlayer == "" {
+		return nil, nil
+	}
+	return audio.NewCommand(conf.Settings.CryPlayer, ".ogg")
+}
+
+type cryResult struct {
+	msg     i18n.Printer
+	Pokemon string `json:"pokemon"`
+	Legacy  bool   `json:"legacy"`
+	URL     string `json:"url"`
+	Played  bool   `json:"played"`
+	Saved   string `json:"saved,omitempty"`
+}
+
+func (r cryResult) renderText(w io.Writer, th theme.Theme) {
+	defer fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, ".\n.")
+	if r.Played {
+		fmt.Fprintln(w, r.msg.Sprintf("%s cries out!", r.Pokemon))
+	}
+	if r.Saved != "" {
+		fmt.Fprintln(w, r.msg.Sprintf("Saved the cry of %s to %s", r.Pokemon, r.Saved))
+	}
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/acehotel33/pokedex-cli/globals"
+	"github.com/acehotel33/pokedex-cli/internal/api"
+	"github.com/acehotel33/pokedex-cli/internal/i18n"
+	"github.com/acehotel33/pokedex-cli/internal/theme"
+)
+
+// encounterSummary describes how a pokemon is met with one encounter method,
+// merged over all encounter details and game versions.
+type encounterSummary struct {
+	Method   string   `json:"method"`
+	MinLevel int      `json:"min_level"`
+	MaxLevel int      `json:"max_level"`
+	Chance   int      `json:"chance"`
+	Versions []string `json:"versions"`
+}
+
+// summarizeEncounters merges the encounter details per method. Chance is the
+// best per-version total for the method. If version is not empty only that
+// game version is considered.
+func summarizeEncounters(details []globals.VersionEncounterDetail, version string) []encounterSummary {
+	byMethod := map[string]*encounterSummary{}
+	order := []string{}
+
+	for _, versionDetail := range details {
+		if version != "" && versionDetail.Version.Name != version {
+			continue
+		}
+		chances := map[string]int{}
+		for _, detail := range versionDetail.EncounterDetails {
+			method := detail.Method.Name
+			summary, ok := byMethod[method]
+			if !ok {
+				summary = &encounterSummary{Method: method, MinLevel: detail.MinLevel, MaxLevel: detail.MaxLevel}
+				byMethod[method] = summary
+				order = append(order, method)
+			}
+			summary.MinLevel = min(summary.MinLevel, detail.MinLevel)
+			summary.MaxLevel = max(summary.MaxLevel, detail.MaxLevel)
+			chances[method] += detail.Chance
+		}
+		for method, chance := range chances {
+			summary := byMethod[method]
+			summary.Chance = max(summary.Chance, chance)
+			summary.Versions = append(summary.Versions, versionDetail.Version.Name)
+		}
+	}
+
+	summaries := make([]encounterSummary, 0, len(order))
+	for _, method := range order {
+		summaries = append(summaries, *byMethod[method])
+	}
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return summaries[i].Chance > summaries[j].Chance
+	})
+	return summaries
+}
+
+// gameVersion turns the version setting or flag into a filter for
+// summarizeEncounters, where "" stands for all versions.
+func gameVersion(version string) string {
+	version = strings.ToLower(version)
+	if version == "all" {
+		return ""
+	}
+	return version
+}
+
+type pokemonEncounters struct {
+	Pokemon    string             `json:"pokemon"`
+	Encounters []encounterSummary `json:"encounters"`
+}
+
+type methodRate struct {
+	Method string `json:"method"`
+	Rate   int    `json:"rate"`
+}
+
+// summarizeMethodRates returns how often each encounter method triggers an
+// encounter in the area, the highest rate over all versions unless version
+// is given.
+func summarizeMethodRates(area globals.Area, version string) []methodRate {
+	rates := []methodRate{}
+	for _, methodRates := range area.EncounterMethodRates {
+		rate, found := 0, false
+		for _, versionDetail := range methodRates.VersionDetails {
+			if version != "" && versionDetail.Version.Name != version {
+				continue
+			}
+			rate, found = max(rate, versionDetail.Rate), true
+		}
+		if found {
+			rates = append(rates, methodRate{Method: methodRates.EncounterMethod.Name, Rate: rate})
+		}
+	}
+	return rates
+}
+
+func commandWherePokemon(conf *globals.Config, params []string) error {
+	fs := flag.NewFlagSet("where", flag.ContinueOnError)
+	version := fs.String("version", conf.Settings.Version, "only show encounters in this game version, e.g. red, or all")
+	args, err := parseFlags(fs, params)
+	if err != nil {
+		return err
+	}
+	if len(args) < 1 {
+		return i18n.Errorf("missing pokemon")
+	}
+
+	pokemon, err := api.GetPokemon(conf.Endpoint("pokemon", args[0]), conf)
+	if err != nil {
+		return i18n.Errorf("could not find pokemon - %w", err)
+	}
+	encounters, err := api.GetPokemonEncounters(pokemon.LocationAreaEncounters, conf)
+	if err != nil {
+		return i18n.Errorf("could not find encounters of %s - %w", pokemon.Name, err)
+	}
+
+	res := pokemonLocationsResult{msg: i18n.New(conf.Settings.Lang), Pokemon: pokemon.Name, Version: gameVersion(*version), Areas: []areaEncounters{}}
+	for _, encounter := range encounters {
+		summaries := summarizeEncounters(encounter.VersionDetails, res.Version)
+		if len(summaries) == 0 {
+			continue
+		}
+		res.Areas = append(res.Areas, areaEncounters{
+			Area:       encounter.LocationArea.Name,
+			Encounters: summaries,
+		})
+	}
+	return render(conf, res)
+}
+
+type areaEncounters struct {
+	Area       string             `json:"area"`
+	Encounters []encounterSummary `json:"encounters"`
+}
+
+type pokemonLocationsResult struct {
+	msg     i18n.Printer
+	Pokemon string           `json:"pokemon"`
+	Version string           `json:"version,omitempty"`
+	Areas   []areaEncounters `json:"areas"`
+}
+
+func (r pokemonLocationsResult) renderText(w io.Writer, th theme.Theme) {
+	defer fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, ".\n.")
+	if len(r.Areas) == 0 {
+		if r.Version != "" {
+			fmt.Fprintln(w, r.msg.Sprintf("%s can't be found in the wild in %s.", r.Pokemon, r.Version))
+		} else {
+			fmt.Fprintln(w, r.msg.Sprintf("%s can't be found in the wild.", r.Pokemon))
+		}
+		return
+	}
+
+	fmt.Fprintln(w, r.msg.Sprintf("%s can be found in:", r.Pokemon))
+	for _, area := range r.Areas {
+		fmt.Fprintln(w, th.Heading(area.Area))
+		for _, encounter := range area.Encounters {
+			fmt.Fprintf(w, "  - %-12s %-9s %3d%%  %s\n",
+				encounter.Method,
+				levelRange(encounter.MinLevel, encounter.MaxLevel, r.msg),
+				encounter.Chance,
+				th.Muted(strings.Join(encounter.Versions, ", ")))
+		}
+	}
+}
+
+func levelRange(minLevel, maxLevel int, msg i18n.Printer) string {
+	if minLevel == maxLevel {
+		return msg.Sprintf("lv %d", minLevel)
+	}
+	return msg.Sprintf("lv %d-%d", minLevel, maxLevel)
+}
@@ -0,0 +1,383 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/acehotel33/pokedex-cli/globals"
+	"github.com/acehotel33/pokedex-cli/internal/api"
+	"github.com/acehotel33/pokedex-cli/internal/i18n"
+	"github.com/acehotel33/pokedex-cli/internal/theme"
+)
+
+// evolutionNode is a species in an evolution tree with the conditions to
+// evolve into it.
+type evolutionNode struct {
+	Species    string          `json:"species"`
+	Conditions []string        `json:"conditions,omitempty"`
+	EvolvesTo  []evolutionNode `json:"evolves_to"`
+}
+
+func commandEvolutions(conf *globals.Config, params []string) error {
+	if len(params) < 1 {
+		return i18n.Errorf("missing pokemon")
+	}
+
+	pokemon, err := api.GetPokemon(conf.Endpoint("pokemon", params[0]), conf)
+	if err != nil {
+		return i18n.Errorf("could not find pokemon - %w", err)
+	}
+	species, chain, err := fetchEvolutionChain(conf, pokemon)
+	if err != nil {
+		return err
+	}
+	msg := i18n.New(conf.Settings.Lang)
+	return render(conf, evolutionsResult{
+		msg:     msg,
+		Pokemon: pokemon.Name,
+		species: species.Name,
+		Chain:   newEvolutionNode(chain.Chain, msg),
+	})
+}
+
+func commandEvolve(conf *globals.Config, params []string) error {
+	if len(params) < 1 {
+		return i18n.Errorf("missing pokemon")
+	}
+	pokemon, exists := conf.Pokedex[params[0]]
+	if !exists {
+		return i18n.Errorf("you have not caught %s", params[0])
+	}
+
+	species, chain, err := fetchEvolutionChain(conf, pokemon)
+	if err != nil {
+		return err
+	}
+	link := findEvolutionLink(chain.Chain, species.Name)
+	if link == nil || len(link.EvolvesTo) == 0 {
+		return i18n.Errorf("%s does not evolve any further", pokemon.Name)
+	}
+
+	candidates := link.EvolvesTo
+	if len(params) > 1 {
+		candidates = slices.DeleteFunc(slices.Clone(candidates), func(next globals.EvolutionLink) bool {
+			return next.Species.Name != params[1]
+		})
+		if len(candidates) == 0 {
+			return i18n.Errorf("%s does not evolve into %s", pokemon.Name, params[1])
+		}
+	}
+
+	msg := i18n.New(conf.Settings.Lang)
+	now := time.Now()
+	ready, reasons := []string{}, []string{}
+	for _, next := range candidates {
+		reason := ""
+		for _, detail := range next.EvolutionDetails {
+			reason = unmetEvolutionCondition(conf, pokemon, species, detail, now, msg)
+			if reason == "" {
+				break
+			}
+		}
+		if reason == "" {
+			ready = append(ready, next.Species.Name)
+		} else {
+			reasons = append(reasons, next.Species.Name+" "+reason)
+		}
+	}
+	switch {
+	case len(ready) == 0:
+		return i18n.Errorf("%s can't evolve yet: %s", pokemon.Name, strings.Join(reasons, "; "))
+	case len(ready) > 1:
+		return i18n.Errorf("%s can evolve into %s - choose one with 'evolve %s <pokemon>'", pokemon.Name, strings.Join(ready, ", "), pokemon.Name)
+	}
+
+	evolved, err := evolvedPokemon(conf, pokemon, species, ready[0])
+	if err != nil {
+		return err
+	}
+	if _, exists := conf.Pokedex[evolved.Name]; exists {
+		return i18n.Errorf("pokemon %s already in pokedex", evolved.Name)
+	}
+	evolved.Level = pokemon.Level
+	evolved.Shiny = pokemon.Shiny
+	evolved.Form = evolvedForm(pokemon, evolved)
+
+	delete(conf.Pokedex, pokemon.Name)
+	conf.Pokedex[evolved.Name] = evolved
+	if conf.Lead == pokemon.Name {
+		conf.Lead = evolved.Name
+	}
+	if err := saveGame(conf); err != nil {
+		return err
+	}
+	return render(conf, evolveResult{msg: msg, From: pokemon.Name, To: evolved.Name, pokemonType: primaryType(evolved)})
+}
+
+// evolvedPokemon returns the pokemon that pokemon, of species, becomes when
+// it evolves into the species into: the same regional variety of into if it
+// has one, e.g. raichu-alola for pikachu-alola, or else its default variety.
+// Species such as darmanitan have no pokemon of their own name.
+func evolvedPokemon(conf *globals.Config, pokemon globals.Pokemon, species globals.Species, into string) (globals.Pokemon, error) {
+	next, err := api.GetSpecies(conf.Endpoint("pokemon-species", into), conf)
+	if err != nil {
+		return globals.Pokemon{}, i18n.Errorf("could not look up species of %s - %w", into, err)
+	}
+	name := into
+	for _, variety := range next.Varieties {
+		if variety.IsDefault {
+			name = variety.Pokemon.Name
+		}
+	}
+	if suffix := varietySuffix(pokemon, species); suffix != "" {
+		for _, variety := range next.Varieties {
+			if variety.Pokemon.Name == next.Name+"-"+suffix {
+				name = variety.Pokemon.Name
+			}
+		}
+	}
+	evolved, err := api.GetPokemon(conf.Endpoint("pokemon", name), conf)
+	if err != nil {
+		return globals.Pokemon{}, i18n.Errorf("could not find pokemon - %w", err)
+	}
+	return evolved, nil
+}
+
+// varietySuffix returns what sets a pokemon apart from the default variety
+// of its species, e.g. "alola" for raichu-alola, or "" for the default
+// variety itself.
+func varietySuffix(pokemon globals.Pokemon, species globals.Species) string {
+	for _, variety := range species.Varieties {
+		if variety.Pokemon.Name == pokemon.Name && !variety.IsDefault {
+			return strings.TrimPrefix(pokemon.Name, species.Name+"-")
+		}
+	}
+	return ""
+}
+
+func fetchEvolutionChain(conf *globals.Config, pokemon globals.Pokemon) (globals.Species, globals.EvolutionChain, error) {
+	species, err := api.GetSpecies(pokemon.Species.URL, conf)
+	if err != nil {
+		return globals.Species{}, globals.EvolutionChain{}, i18n.Errorf("could not look up species of %s - %w", pokemon.Name, err)
+	}
+	if species.EvolutionChain.URL == "" {
+		return globals.Species{}, globals.EvolutionChain{}, i18n.Errorf("%s has no evolutions", pokemon.Name)
+	}
+	chain, err := api.GetEvolutionChain(species.EvolutionChain.URL, conf)
+	if err != nil {
+		return globals.Species{}, globals.EvolutionChain{}, i18n.Errorf("could not look up evolutions of %s - %w", pokemon.Name, err)
+	}
+	return species, chain, nil
+}
+
+func findEvolutionLink(link globals.EvolutionLink, species string) *globals.EvolutionLink {
+	if link.Species.Name == species {
+		return &link
+	}
+	for _, next := range link.EvolvesTo {
+		if found := findEvolutionLink(next, species); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func newEvolutionNode(link globals.EvolutionLink, msg i18n.Printer) evolutionNode {
+	node := evolutionNode{Species: link.Species.Name, EvolvesTo: []evolutionNode{}}
+	for _, detail := range link.EvolutionDetails {
+		if condition := describeEvolution(detail, msg); !slices.Contains(node.Conditions, condition) {
+			node.Conditions = append(node.Conditions, condition)
+		}
+	}
+	for _, next := range link.EvolvesTo {
+		node.EvolvesTo = append(node.EvolvesTo, newEvolutionNode(next, msg))
+	}
+	return node
+}
+
+// describeEvolution describes the conditions of an evolution, e.g.
+// "level 16" or "use water-stone".
+func describeEvolution(detail globals.EvolutionDetail, msg i18n.Printer) string {
+	parts := []string{}
+	switch detail.Trigger.Name {
+	case "level-up":
+		if detail.MinLevel > 0 {
+			parts = append(parts, msg.Sprintf("level %d", detail.MinLevel))
+		} else {
+			parts = append(parts, msg.Sprintf("level up"))
+		}
+	case "use-item":
+		parts = append(parts, msg.Sprintf("use %s", detail.Item.Name))
+	case "trade":
+		parts = append(parts, msg.Sprintf("trade"))
+	default:
+		parts = append(parts, strings.ReplaceAll(detail.Trigger.Name, "-", " "))
+	}
+
+	if detail.MinHappiness > 0 {
+		parts = append(parts, msg.Sprintf("happiness %d+", detail.MinHappiness))
+	}
+	if detail.MinAffection > 0 {
+		parts = append(parts, msg.Sprintf("affection %d+", detail.MinAffection))
+	}
+	if detail.MinBeauty > 0 {
+		parts = append(parts, msg.Sprintf("beauty %d+", detail.MinBeauty))
+	}
+	if detail.HeldItem.Name != "" {
+		parts = append(parts, msg.Sprintf("holding %s", detail.HeldItem.Name))
+	}
+	if detail.KnownMove.Name != "" {
+		parts = append(parts, msg.Sprintf("knowing %s", detail.KnownMove.Name))
+	}
+	if detail.KnownMoveType.Name != "" {
+		parts = append(parts, msg.Sprintf("knowing a %s move", detail.KnownMoveType.Name))
+	}
+	if detail.Location.Name != "" {
+		parts = append(parts, msg.Sprintf("at %s", detail.Location.Name))
+	}
+	if detail.TimeOfDay != "" {
+		parts = append(parts, msg.Sprintf("at %s", msg.Sprintf(detail.TimeOfDay)))
+	}
+	if detail.TradeSpecies.Name != "" {
+		parts = append(parts, msg.Sprintf("for %s", detail.TradeSpecies.Name))
+	}
+	if detail.PartySpecies.Name != "" {
+		parts = append(parts, msg.Sprintf("with %s in the party", detail.PartySpecies.Name))
+	}
+	if detail.PartyType.Name != "" {
+		parts = append(parts, msg.Sprintf("with a %s type in the party", detail.PartyType.Name))
+	}
+	switch detail.Gender {
+	case 1:
+		parts = append(parts, msg.Sprintf("female"))
+	case 2:
+		parts = append(parts, msg.Sprintf("male"))
+	}
+	switch {
+	case detail.RelativePhysicalStats > 0:
+		parts = append(parts, msg.Sprintf("attack > defense"))
+	case detail.RelativePhysicalStats < 0:
+		parts = append(parts, msg.Sprintf("attack < defense"))
+	}
+	if detail.NeedsOverworldRain {
+		parts = append(parts, msg.Sprintf("in the rain"))
+	}
+	if detail.TurnUpsideDown {
+		parts = append(parts, msg.Sprintf("upside down"))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// unmetEvolutionCondition returns why pokemon can't evolve as described by
+// detail, or "" if it can. Happiness is the species' base happiness, as the
+// Pokedex doesn't track it.
+func unmetEvolutionCondition(conf *globals.Config, pokemon globals.Pokemon, species globals.Species, detail globals.EvolutionDetail, now time.Time, msg i18n.Printer) string {
+	switch detail.Trigger.Name {
+	case "level-up":
+	case "use-item":
+		return msg.Sprintf("needs %s, which your bag can't hold", detail.Item.Name)
+	case "trade":
+		return msg.Sprintf("needs to be traded")
+	default:
+		return msg.Sprintf("needs to %s", describeEvolution(detail, msg))
+	}
+
+	switch {
+	case detail.MinLevel > 0 && pokemon.Level == 0:
+		return msg.Sprintf("needs level %d, but only pokemon caught in game mode have a level", detail.MinLevel)
+	case pokemon.Level < detail.MinLevel:
+		return msg.Sprintf("needs level %d (it is level %d)", detail.MinLevel, pokemon.Level)
+	case species.BaseHappiness < detail.MinHappiness:
+		return msg.Sprintf("needs happiness %d (it has %d)", detail.MinHappiness, species.BaseHappiness)
+	case detail.KnownMove.Name != "" && !slices.Contains(learnedMoves(pokemon, pokemon.Level), detail.KnownMove.Name):
+		return msg.Sprintf("needs to know %s", detail.KnownMove.Name)
+	case detail.Location.Name != "" && conf.Position.Location != detail.Location.Name:
+		return msg.Sprintf("needs to level up at %s", detail.Location.Name)
+	case detail.TimeOfDay != "" && timeOfDay(now) != detail.TimeOfDay:
+		return msg.Sprintf("needs to level up at %s", msg.Sprintf(detail.TimeOfDay))
+	case detail.MinAffection > 0, detail.MinBeauty > 0, detail.HeldItem.Name != "", detail.KnownMoveType.Name != "",
+		detail.PartySpecies.Name != "", detail.PartyType.Name != "", detail.Gender != 0,
+		detail.RelativePhysicalStats != 0, detail.NeedsOverworldRain, detail.TurnUpsideDown:
+		return msg.Sprintf("needs to %s, which the Pokedex can't check", describeEvolution(detail, msg))
+	}
+	return ""
+}
+
+// evolvedForm returns the form of evolved matching the cosmetic form of
+// pokemon, e.g. charmeleon-b for charmander-b, or "" if it has none.
+func evolvedForm(pokemon, evolved globals.Pokemon) string {
+	if pokemon.Form == "" {
+		return ""
+	}
+	form := evolved.Name + "-" + strings.TrimPrefix(pokemon.Form, pokemon.Name+"-")
+	for _, evolvedForm := range evolved.Forms {
+		if evolvedForm.Name == form {
+			return form
+		}
+	}
+	return ""
+}
+
+// timeOfDay returns the PokeAPI time of day of t: day, dusk or night.
+func timeOfDay(t time.Time) string {
+	switch hour := t.Hour(); {
+	case hour >= 6 && hour < 18:
+		return "day"
+	case hour == 18:
+		return "dusk"
+	default:
+		return "night"
+	}
+}
+
+type evolutionsResult struct {
+	msg     i18n.Printer
+	species string
+	Pokemon string        `json:"pokemon"`
+	Chain   evolutionNode `json:"chain"`
+}
+
+func (r evolutionsResult) renderText(w io.Writer, th theme.Theme) {
+	defer fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, r.msg.Sprintf("Evolutions of %s:", r.Pokemon))
+	r.renderNode(w, th, r.Chain, "", "")
+}
+
+// renderNode draws node and its evolutions as a tree, with first in front
+// of the node and prefix in front of the lines below it.
+func (r evolutionsResult) renderNode(w io.Writer, th theme.Theme, node evolutionNode, first, prefix string) {
+	name := node.Species
+	if name == r.species {
+		name = th.Heading(name)
+	}
+	if len(node.Conditions) > 0 {
+		name += " " + th.Muted("("+strings.Join(node.Conditions, r.msg.Sprintf(" or "))+")")
+	}
+	fmt.Fprintln(w, first+name)
+
+	for i, next := range node.EvolvesTo {
+		if i == len(node.EvolvesTo)-1 {
+			r.renderNode(w, th, next, prefix+"└─ ", prefix+"   ")
+		} else {
+			r.renderNode(w, th, next, prefix+"├─ ", prefix+"│  ")
+		}
+	}
+}
+
+type evolveResult struct {
+	msg         i18n.Printer
+	pokemonType string
+	From        string `json:"from"`
+	To          string `json:"to"`
+}
+
+func (r evolveResult) renderText(w io.Writer, th theme.Theme) {
+	defer fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, r.msg.Sprintf("What? %s is evolving!", r.From))
+	fmt.Fprintln(w, r.msg.Sprintf("%s Your %s evolved into %s!", th.Success(r.msg.Sprintf("Congratulations!")), r.From, th.Type(r.pokemonType, r.To)))
+}
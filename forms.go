@@ -0,0 +1,52 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/acehotel33/pokedex-cli/globals"
+	"github.com/acehotel33/pokedex-cli/internal/api"
+	"github.com/acehotel33/pokedex-cli/internal/i18n"
+)
+
+// findForm returns pokemon in the named form, given either in full, e.g.
+// raichu-alola, or without the species, e.g. alola. Varieties of the
+// species, such as regional variants, are pokemon of their own; other forms
+// only change how the pokemon looks and are recorded on it.
+func findForm(conf *globals.Config, pokemon globals.Pokemon, species globals.Species, form string) (globals.Pokemon, error) {
+	matches := func(name string) bool {
+		return name == form || name == species.Name+"-"+form
+	}
+
+	available := []string{}
+	for _, variety := range species.Varieties {
+		name := variety.Pokemon.Name
+		if matches(name) {
+			if name == pokemon.Name {
+				return pokemon, nil
+			}
+			found, err := api.GetPokemon(conf.Endpoint("pokemon", name), conf)
+			if err != nil {
+				return globals.Pokemon{}, i18n.Errorf("could not find form %s - %w", name, err)
+			}
+			return found, nil
+		}
+		if !variety.IsDefault {
+			available = append(available, name)
+		}
+	}
+	for _, pokemonForm := range pokemon.Forms {
+		if pokemonForm.Name == pokemon.Name {
+			continue
+		}
+		if matches(pokemonForm.Name) {
+			pokemon.Form = pokemonForm.Name
+			return pokemon, nil
+		}
+		available = append(available, pokemonForm.Name)
+	}
+
+	if len(available) == 0 {
+		return globals.Pokemon{}, i18n.Errorf("%s has no other forms", pokemon.Name)
+	}
+	return globals.Pokemon{}, i18n.Errorf("%s has no form %s - expected one of %s", pokemon.Name, form, strings.Join(available, ", "))
+}
@@ -1,20 +1,82 @@
 package globals
 
 import (
-	"time"
+	"io"
+	"math/rand"
+	"strconv"
+	"strings"
 
+	"github.com/acehotel33/pokedex-cli/internal/audio"
 	"github.com/acehotel33/pokedex-cli/internal/cache"
+	"github.com/acehotel33/pokedex-cli/internal/settings"
+	"github.com/acehotel33/pokedex-cli/internal/theme"
 )
 
-var LocationsAllURL = "https://pokeapi.co/api/v2/location-area/"
-var PokemonURL = "https://pokeapi.co/api/v2/pokemon/"
-
-var Cache = cache.NewCache(5 * time.Minute)
+// Output formats accepted by the --output flag.
+const (
+	OutputText = "text"
+	OutputJSON = "json"
+)
 
 type Config struct {
-	NextURL     string `json:"next"`
-	PreviousURL string `json:"previous"`
-	Pokedex     map[string]Pokemon
+	MapPage    int
+	MapLimit   int
+	MapCount   int
+	Pokedex    map[string]Pokemon
+	Output     string
+	Out        io.Writer
+	Settings   settings.Settings
+	ConfigPath string
+	Cache      *cache.Cache
+	Theme      theme.Theme
+	Position   Position
+	AreaIndex  []NamedResource
+	Encounter  *WildEncounter
+	Inventory  map[string]int
+	// Lead is the pokedex entry sent out to fight wild pokemon.
+	Lead string
+	// Rand is the source of every random roll, seeded with Seed so a
+	// session can be replayed.
+	Rand *rand.Rand
+	Seed int64
+	// Player plays cries, overriding the cry_player setting when set.
+	Player audio.Player
+	// Fast skips the catch animation for the session, whatever the
+	// animation setting says.
+	Fast bool
+}
+
+// Reseed restarts the random rolls from seed.
+func (c *Config) Reseed(seed int64) {
+	c.Seed = seed
+	c.Rand = rand.New(rand.NewSource(seed))
+}
+
+// WildEncounter is the wild pokemon the trainer is currently facing.
+type WildEncounter struct {
+	Pokemon string `json:"pokemon"`
+	Level   int    `json:"level"`
+	Method  string `json:"method"`
+	Area    string `json:"area"`
+	// HP and MaxHP are zero until the pokemon has been fought, which means
+	// it is at full health.
+	HP     int    `json:"hp,omitempty"`
+	MaxHP  int    `json:"max_hp,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+// Position is where the trainer currently is. Empty fields mean the trainer
+// has not travelled anywhere yet.
+type Position struct {
+	Region   string `json:"region"`
+	Location string `json:"location"`
+	Area     string `json:"area"`
+}
+
+// Endpoint returns the URL of a PokeAPI resource, e.g.
+// Endpoint("pokemon", "pikachu"). An empty name gives the resource list.
+func (c *Config) Endpoint(resource, name string) string {
+	return c.Settings.APIURL + resource + "/" + name
 }
 
 type CliCommand struct {
@@ -28,6 +90,44 @@ type LocationArea struct {
 	URL  string `json:"url"`
 }
 
+// NamedResource is a reference to another PokeAPI resource.
+type NamedResource struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// ID returns the numeric id at the end of the resource URL, or 0 if the URL
+// does not end in one.
+func (r NamedResource) ID() int {
+	parts := strings.Split(strings.TrimSuffix(r.URL, "/"), "/")
+	id, err := strconv.Atoi(parts[len(parts)-1])
+	if err != nil {
+		return 0
+	}
+	return id
+}
+
+// ResourceList is a page of a PokeAPI resource list.
+type ResourceList struct {
+	Count       int             `json:"count"`
+	NextURL     string          `json:"next"`
+	PreviousURL string          `json:"previous"`
+	Results     []NamedResource `json:"results"`
+}
+
+type Region struct {
+	ID        int             `json:"id"`
+	Name      string          `json:"name"`
+	Locations []NamedResource `json:"locations"`
+}
+
+type Location struct {
+	ID     int             `json:"id"`
+	Name   string          `json:"name"`
+	Region NamedResource   `json:"region"`
+	Areas  []NamedResource `json:"areas"`
+}
+
 type LocationAreasAll struct {
 	Count       int            `json:"count"`
 	NextURL     string         `json:"next"`
@@ -68,26 +168,201 @@ type Area struct {
 			Name string `json:"name"`
 			URL  string `json:"url"`
 		} `json:"pokemon"`
-		VersionDetails []struct {
-			EncounterDetails []struct {
-				Chance          int   `json:"chance"`
-				ConditionValues []any `json:"condition_values"`
-				MaxLevel        int   `json:"max_level"`
-				Method          struct {
-					Name string `json:"name"`
-					URL  string `json:"url"`
-				} `json:"method"`
-				MinLevel int `json:"min_level"`
-			} `json:"encounter_details"`
-			MaxChance int `json:"max_chance"`
-			Version   struct {
-				Name string `json:"name"`
-				URL  string `json:"url"`
-			} `json:"version"`
-		} `json:"version_details"`
+		VersionDetails []VersionEncounterDetail `json:"version_details"`
 	} `json:"pokemon_encounters"`
 }
 
+// Item is the PokeAPI item resource, e.g. poke-ball.
+type Item struct {
+	ID            int           `json:"id"`
+	Name          string        `json:"name"`
+	Cost          int           `json:"cost"`
+	Category      NamedResource `json:"category"`
+	Names         []Name        `json:"names"`
+	EffectEntries []struct {
+		Effect      string        `json:"effect"`
+		ShortEffect string        `json:"short_effect"`
+		Language    NamedResource `json:"language"`
+	} `json:"effect_entries"`
+}
+
+// Name is the name of a resource in one language.
+type Name struct {
+	Language NamedResource `json:"language"`
+	Name     string        `json:"name"`
+}
+
+// Species is the PokeAPI pokemon-species resource, shared by all forms of a
+// pokemon.
+type Species struct {
+	ID                 int           `json:"id"`
+	Name               string        `json:"name"`
+	Order              int           `json:"order"`
+	GenderRate         int           `json:"gender_rate"`
+	CaptureRate        int           `json:"capture_rate"`
+	BaseHappiness      int           `json:"base_happiness"`
+	IsBaby             bool          `json:"is_baby"`
+	IsLegendary        bool          `json:"is_legendary"`
+	IsMythical         bool          `json:"is_mythical"`
+	HatchCounter       int           `json:"hatch_counter"`
+	GrowthRate         NamedResource `json:"growth_rate"`
+	EvolvesFromSpecies NamedResource `json:"evolves_from_species"`
+	EvolutionChain     struct {
+		URL string `json:"url"`
+	} `json:"evolution_chain"`
+	Habitat           NamedResource `json:"habitat"`
+	Color             NamedResource `json:"color"`
+	Shape             NamedResource `json:"shape"`
+	Generation        NamedResource `json:"generation"`
+	Names             []Name        `json:"names"`
+	FlavorTextEntries []struct {
+		FlavorText string        `json:"flavor_text"`
+		Language   NamedResource `json:"language"`
+		Version    NamedResource `json:"version"`
+	} `json:"flavor_text_entries"`
+	Genera []struct {
+		Genus    string        `json:"genus"`
+		Language NamedResource `json:"language"`
+	} `json:"genera"`
+	Varieties []struct {
+		IsDefault bool          `json:"is_default"`
+		Pokemon   NamedResource `json:"pokemon"`
+	} `json:"varieties"`
+}
+
+// Move is the PokeAPI move resource.
+type Move struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	// Accuracy and Power are 0 for moves that never miss and moves that do
+	// no direct damage.
+	Accuracy     int           `json:"accuracy"`
+	Power        int           `json:"power"`
+	PP           int           `json:"pp"`
+	Priority     int           `json:"priority"`
+	Type         NamedResource `json:"type"`
+	DamageClass  NamedResource `json:"damage_class"`
+	EffectChance *int          `json:"effect_chance"` // nil for moves whose effect always happens
+	Meta         struct {
+		Ailment       NamedResource `json:"ailment"`
+		AilmentChance int           `json:"ailment_chance"`
+	} `json:"meta"`
+	EffectEntries []struct {
+		Effect      string        `json:"effect"`
+		ShortEffect string        `json:"short_effect"`
+		Language    NamedResource `json:"language"`
+	} `json:"effect_entries"`
+	Names []Name `json:"names"`
+}
+
+// PokemonForm is the PokeAPI pokemon-form resource, one look of a pokemon
+// such as unown-b.
+type PokemonForm struct {
+	Name     string        `json:"name"`
+	FormName string        `json:"form_name"`
+	Pokemon  NamedResource `json:"pokemon"`
+	Sprites  struct {
+		BackDefault  string `json:"back_default"`
+		BackShiny    string `json:"back_shiny"`
+		FrontDefault string `json:"front_default"`
+		FrontShiny   string `json:"front_shiny"`
+	} `json:"sprites"`
+}
+
+// Ability is the PokeAPI ability resource.
+type Ability struct {
+	ID            int           `json:"id"`
+	Name          string        `json:"name"`
+	Generation    NamedResource `json:"generation"`
+	EffectEntries []struct {
+		Effect      string        `json:"effect"`
+		ShortEffect string        `json:"short_effect"`
+		Language    NamedResource `json:"language"`
+	} `json:"effect_entries"`
+	Names   []Name `json:"names"`
+	Pokemon []struct {
+		IsHidden bool          `json:"is_hidden"`
+		Slot     int           `json:"slot"`
+		Pokemon  NamedResource `json:"pokemon"`
+	} `json:"pokemon"`
+}
+
+// Type is the PokeAPI type resource.
+type Type struct {
+	ID              int    `json:"id"`
+	Name            string `json:"name"`
+	DamageRelations struct {
+		DoubleDamageFrom []NamedResource `json:"double_damage_from"`
+		DoubleDamageTo   []NamedResource `json:"double_damage_to"`
+		HalfDamageFrom   []NamedResource `json:"half_damage_from"`
+		HalfDamageTo     []NamedResource `json:"half_damage_to"`
+		NoDamageFrom     []NamedResource `json:"no_damage_from"`
+		NoDamageTo       []NamedResource `json:"no_damage_to"`
+	} `json:"damage_relations"`
+	Names []Name `json:"names"`
+}
+
+// EvolutionChain is the PokeAPI evolution-chain resource.
+type EvolutionChain struct {
+	ID    int           `json:"id"`
+	Chain EvolutionLink `json:"chain"`
+}
+
+// EvolutionLink is one species in an evolution chain with the species it
+// evolves into.
+type EvolutionLink struct {
+	IsBaby  bool          `json:"is_baby"`
+	Species NamedResource `json:"species"`
+	// EvolutionDetails are the ways to evolve into Species, one per game
+	// generation that differs. It is empty for the first species.
+	EvolutionDetails []EvolutionDetail `json:"evolution_details"`
+	EvolvesTo        []EvolutionLink   `json:"evolves_to"`
+}
+
+// EvolutionDetail is one set of conditions for an evolution. Zero values
+// mean there is no such condition.
+type EvolutionDetail struct {
+	Trigger               NamedResource `json:"trigger"`
+	Item                  NamedResource `json:"item"`
+	HeldItem              NamedResource `json:"held_item"`
+	KnownMove             NamedResource `json:"known_move"`
+	KnownMoveType         NamedResource `json:"known_move_type"`
+	Location              NamedResource `json:"location"`
+	PartySpecies          NamedResource `json:"party_species"`
+	PartyType             NamedResource `json:"party_type"`
+	TradeSpecies          NamedResource `json:"trade_species"`
+	Gender                int           `json:"gender"`
+	MinLevel              int           `json:"min_level"`
+	MinHappiness          int           `json:"min_happiness"`
+	MinBeauty             int           `json:"min_beauty"`
+	MinAffection          int           `json:"min_affection"`
+	RelativePhysicalStats int           `json:"relative_physical_stats"`
+	NeedsOverworldRain    bool          `json:"needs_overworld_rain"`
+	TurnUpsideDown        bool          `json:"turn_upside_down"`
+	TimeOfDay             string        `json:"time_of_day"`
+}
+
+// PokemonEncounter is one location area a pokemon can be met in, as listed
+// by the Pokemon.LocationAreaEncounters resource.
+type PokemonEncounter struct {
+	LocationArea   NamedResource            `json:"location_area"`
+	VersionDetails []VersionEncounterDetail `json:"version_details"`
+}
+
+type VersionEncounterDetail struct {
+	EncounterDetails []EncounterDetail `json:"encounter_details"`
+	MaxChance        int               `json:"max_chance"`
+	Version          NamedResource     `json:"version"`
+}
+
+type EncounterDetail struct {
+	Chance          int             `json:"chance"`
+	ConditionValues []NamedResource `json:"condition_values"`
+	MaxLevel        int             `json:"max_level"`
+	Method          NamedResource   `json:"method"`
+	MinLevel        int             `json:"min_level"`
+}
+
 type Pokemon struct {
 	ID             int    `json:"id"`
 	Name           string `json:"name"`
@@ -358,4 +633,16 @@ type Pokemon struct {
 			} `json:"type"`
 		} `json:"types"`
 	} `json:"past_types"`
+	// Level, Shiny and Form are only set on a caught specimen, they are not
+	// part of the PokeAPI resource. Form is the cosmetic form caught, e.g.
+	// unown-b, if it isn't the default one.
+	Level int    `json:"level,omitempty"`
+	Shiny bool   `json:"shiny,omitempty"`
+	Form  string `json:"form,omitempty"`
+	// HP is what a caught specimen has left after its last battle, zero at
+	// full health, and Status the status condition it was left with. A
+	// fainted pokemon can't fight until it is healed.
+	HP      int    `json:"hp,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Fainted bool   `json:"fainted,omitempty"`
 }
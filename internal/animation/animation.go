@@ -0,0 +1,75 @@
+package animation
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// Animation speeds.
+const (
+	Off    = "off"
+	Normal = "normal"
+	Slow   = "slow"
+)
+
+// spinnerFrames are cycled through on a terminal while the ball shakes.
+var spinnerFrames = []string{"|", "/", "-", "\\"}
+
+// framesPerStep is how many spinner frames are drawn in one step.
+const framesPerStep = 4
+
+// Speeds lists the valid speed settings.
+func Speeds() []string {
+	return []string{Off, Normal, Slow}
+}
+
+// Valid reports whether speed is one of Speeds.
+func Valid(speed string) bool {
+	for _, s := range Speeds() {
+		if s == speed {
+			return true
+		}
+	}
+	return false
+}
+
+// Player draws the steps of an animation on w. The zero value plays
+// nothing and never sleeps.
+type Player struct {
+	w    io.Writer
+	step time.Duration
+}
+
+// New returns a Player pausing for step between the steps of an animation
+// at normal speed. Animations are switched off unless tty is set, so that
+// scripts and pipes don't stall; on a terminal a spinner is drawn while
+// waiting.
+func New(w io.Writer, speed string, step time.Duration, tty bool) Player {
+	if !tty || speed == Off || step <= 0 {
+		return Player{w: w}
+	}
+	if speed == Slow {
+		step *= 2
+	}
+	return Player{w: w, step: step}
+}
+
+// Pause waits for the given number of steps.
+func (p Player) Pause(steps int) {
+	time.Sleep(time.Duration(steps) * p.step)
+}
+
+// Shake spins for one step and then prints mark on its own line, over the
+// spinner.
+func (p Player) Shake(mark string) {
+	if p.step > 0 {
+		frame := p.step / framesPerStep
+		for i := 0; i < framesPerStep; i++ {
+			fmt.Fprintf(p.w, "\r%s", spinnerFrames[i%len(spinnerFrames)])
+			time.Sleep(frame)
+		}
+		fmt.Fprint(p.w, "\r")
+	}
+	fmt.Fprintln(p.w, mark)
+}
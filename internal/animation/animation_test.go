@@ -0,0 +1,46 @@
+package animation
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestNew(t *testing.T) {
+	cases := []struct {
+		speed string
+		tty   bool
+		step  time.Duration
+	}{
+		{speed: Normal, tty: true, step: 10 * time.Millisecond},
+		{speed: Slow, tty: true, step: 20 * time.Millisecond},
+		{speed: Off, tty: true, step: 0},
+		{speed: Normal, tty: false, step: 0},
+		{speed: Slow, tty: false, step: 0},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			p := New(&bytes.Buffer{}, c.speed, 10*time.Millisecond, c.tty)
+			if p.step != c.step {
+				t.Errorf("expected a step of %v, got %v", c.step, p.step)
+				return
+			}
+		})
+	}
+}
+
+func TestShake(t *testing.T) {
+	out := &bytes.Buffer{}
+	New(out, Normal, time.Millisecond, false).Shake(".")
+	if out.String() != ".\n" {
+		t.Errorf("expected a plain mark off a terminal, got %q", out.String())
+	}
+
+	out.Reset()
+	New(out, Normal, 4*time.Millisecond, true).Shake(".")
+	if out.String() != "\r|\r/\r-\r\\\r.\n" {
+		t.Errorf("expected a spinner before the mark, got %q", out.String())
+	}
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -9,117 +10,236 @@ import (
 	"github.com/acehotel33/pokedex-cli/globals"
 )
 
-func GetLocationAreasAll(url string, conf *globals.Config) ([]globals.LocationArea, error) {
-	if body, exists := globals.Cache.Get(url); exists {
-		var locationAreasAll globals.LocationAreasAll
-		if err := json.Unmarshal(body, &locationAreasAll); err != nil {
-			return nil, fmt.Errorf("could not decode json body into instance of LocationsAll - %w", err)
-		}
-		conf.NextURL = locationAreasAll.NextURL
-		conf.PreviousURL = locationAreasAll.PreviousURL
+// ErrNotFound is returned when the PokeAPI has no resource at a URL.
+var ErrNotFound = errors.New("not found")
 
-		return locationAreasAll.Results, nil
+// getBody returns the response body for url, from the cache if possible.
+func getBody(url string, conf *globals.Config) ([]byte, error) {
+	if body, exists := conf.Cache.Get(url); exists {
+		return body, nil
+	}
 
-	} else {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not create GET request - %w", err)
+	}
 
-		req, err := http.NewRequest("GET", url, nil)
-		if err != nil {
-			return nil, fmt.Errorf("could not create GET request - %w", err)
-		}
+	client := &http.Client{}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not perform GET request - %w", err)
+	}
+	defer res.Body.Close()
 
-		client := &http.Client{}
-		res, err := client.Do(req)
-		if err != nil {
-			return nil, fmt.Errorf("could not perform GET request - %w", err)
-		}
-		defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status code of response is not OK - %v", res.Status)
+	}
 
-		if res.StatusCode != http.StatusOK {
-			return nil, fmt.Errorf("status code of response is not OK - %v", res.Status)
-		}
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not read response body - %w", err)
+	}
 
-		body, err := io.ReadAll(res.Body)
-		if err != nil {
-			return nil, fmt.Errorf("could not read response body - %w", err)
-		}
+	conf.Cache.Add(url, body)
+	return body, nil
+}
+
+// getJSON decodes the JSON resource at url into v.
+func getJSON(url string, conf *globals.Config, v any) error {
+	body, err := getBody(url, conf)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("could not decode json body into %T - %w", v, err)
+	}
+	return nil
+}
 
-		globals.Cache.Add(url, body)
+func GetLocationAreas(url string, conf *globals.Config) (globals.LocationAreasAll, error) {
+	var locationAreasAll globals.LocationAreasAll
+	if err := getJSON(url, conf, &locationAreasAll); err != nil {
+		return globals.LocationAreasAll{}, err
+	}
+	return locationAreasAll, nil
+}
 
-		var locationAreasAll globals.LocationAreasAll
-		if err := json.Unmarshal(body, &locationAreasAll); err != nil {
-			return nil, fmt.Errorf("could not decode json body into instance of LocationsAll - %w", err)
+func GetArea(url string, conf *globals.Config) (globals.Area, error) {
+	var area globals.Area
+	if err := getJSON(url, conf, &area); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return globals.Area{}, fmt.Errorf("location %w", err)
 		}
+		return globals.Area{}, err
+	}
+	return area, nil
+}
 
-		conf.NextURL = locationAreasAll.NextURL
-		conf.PreviousURL = locationAreasAll.PreviousURL
-
-		return locationAreasAll.Results, nil
+func GetPokemon(url string, conf *globals.Config) (globals.Pokemon, error) {
+	var pokemon globals.Pokemon
+	if err := getJSON(url, conf, &pokemon); err != nil {
+		return globals.Pokemon{}, err
 	}
+	return pokemon, nil
+}
 
+// GetResourceList returns every entry of the resource list at url,
+// following the next links until the last page.
+func GetResourceList(url string, conf *globals.Config) ([]globals.NamedResource, error) {
+	results := []globals.NamedResource{}
+	for url != "" {
+		var list globals.ResourceList
+		if err := getJSON(url, conf, &list); err != nil {
+			return nil, err
+		}
+		results = append(results, list.Results...)
+		url = list.NextURL
+	}
+	return results, nil
 }
 
-func ExploreArea(url string, conf *globals.Config) ([]string, error) {
-	if body, exists := globals.Cache.Get(url); exists {
-		var area globals.Area
-		if err := json.Unmarshal(body, &area); err != nil {
-			return nil, fmt.Errorf("could not unmarshal cached body into Area struct - %w", err)
+func GetRegion(url string, conf *globals.Config) (globals.Region, error) {
+	var region globals.Region
+	if err := getJSON(url, conf, &region); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return globals.Region{}, fmt.Errorf("region %w", err)
 		}
-		pokemonEncounters := area.PokemonEncounters
-		pokemonSlice := []string{}
-		for _, item := range pokemonEncounters {
-			pokemonSlice = append(pokemonSlice, item.Pokemon.Name)
+		return globals.Region{}, err
+	}
+	return region, nil
+}
+
+func GetLocation(url string, conf *globals.Config) (globals.Location, error) {
+	var location globals.Location
+	if err := getJSON(url, conf, &location); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return globals.Location{}, fmt.Errorf("location %w", err)
 		}
+		return globals.Location{}, err
+	}
+	return location, nil
+}
 
-		return pokemonSlice, nil
+func GetPokemonEncounters(url string, conf *globals.Config) ([]globals.PokemonEncounter, error) {
+	encounters := []globals.PokemonEncounter{}
+	if err := getJSON(url, conf, &encounters); err != nil {
+		return nil, err
+	}
+	return encounters, nil
+}
 
-	} else {
+func GetItem(url string, conf *globals.Config) (globals.Item, error) {
+	var item globals.Item
+	if err := getJSON(url, conf, &item); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return globals.Item{}, fmt.Errorf("item %w", err)
+		}
+		return globals.Item{}, err
+	}
+	return item, nil
+}
 
-		req, err := http.NewRequest("GET", url, nil)
-		if err != nil {
-			return nil, fmt.Errorf("could not create GET request - %w", err)
+func GetSpecies(url string, conf *globals.Config) (globals.Species, error) {
+	var species globals.Species
+	if err := getJSON(url, conf, &species); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return globals.Species{}, fmt.Errorf("species %w", err)
 		}
+		return globals.Species{}, err
+	}
+	return species, nil
+}
 
-		client := &http.Client{}
-		res, err := client.Do(req)
-		if err != nil {
-			return nil, fmt.Errorf("could not perform request - %w", err)
+func GetMove(url string, conf *globals.Config) (globals.Move, error) {
+	var move globals.Move
+	if err := getJSON(url, conf, &move); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return globals.Move{}, fmt.Errorf("move %w", err)
 		}
-		defer res.Body.Close()
-
-		if res.StatusCode != http.StatusOK {
-			if res.StatusCode == 404 {
-				return nil, fmt.Errorf("location not found")
-			} else {
-				return nil, fmt.Errorf("non-okay response status: %v", res.Status)
-			}
+		return globals.Move{}, err
+	}
+	return move, nil
+}
+
+func GetType(url string, conf *globals.Config) (globals.Type, error) {
+	var pokemonType globals.Type
+	if err := getJSON(url, conf, &pokemonType); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return globals.Type{}, fmt.Errorf("type %w", err)
 		}
+		return globals.Type{}, err
+	}
+	return pokemonType, nil
+}
 
-		var area globals.Area
-		if err := json.NewDecoder(res.Body).Decode(&area); err != nil {
-			return nil, fmt.Errorf("could not decode JSON into Area struct - %w", err)
+func GetAbility(url string, conf *globals.Config) (globals.Ability, error) {
+	var ability globals.Ability
+	if err := getJSON(url, conf, &ability); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return globals.Ability{}, fmt.Errorf("ability %w", err)
 		}
+		return globals.Ability{}, err
+	}
+	return ability, nil
+}
 
-		pokemonEncounters := area.PokemonEncounters
-		pokemonSlice := []string{}
-		for _, item := range pokemonEncounters {
-			pokemonSlice = append(pokemonSlice, item.Pokemon.Name)
+func GetEvolutionChain(url string, conf *globals.Config) (globals.EvolutionChain, error) {
+	var chain globals.EvolutionChain
+	if err := getJSON(url, conf, &chain); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return globals.EvolutionChain{}, fmt.Errorf("evolution chain %w", err)
 		}
+		return globals.EvolutionChain{}, err
+	}
+	return chain, nil
+}
 
-		return pokemonSlice, nil
+func GetPokemonForm(url string, conf *globals.Config) (globals.PokemonForm, error) {
+	var form globals.PokemonForm
+	if err := getJSON(url, conf, &form); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return globals.PokemonForm{}, fmt.Errorf("pokemon form %w", err)
+		}
+		return globals.PokemonForm{}, err
 	}
+	return form, nil
 }
 
-func GetPokemon(url string, conf *globals.Config) (globals.Pokemon, error) {
-	res, err := http.Get(url)
+// GetSprite returns the image file of a sprite.
+func GetSprite(url string, conf *globals.Config) ([]byte, error) {
+	body, err := getBody(url, conf)
 	if err != nil {
-		return globals.Pokemon{}, err
+		if errors.Is(err, ErrNotFound) {
+			return nil, fmt.Errorf("sprite %w", err)
+		}
+		return nil, err
 	}
-	defer res.Body.Close()
+	return body, nil
+}
 
-	var pokemon globals.Pokemon
-	if err := json.NewDecoder(res.Body).Decode(&pokemon); err != nil {
-		return globals.Pokemon{}, err
+// GetCry returns the audio file of a pokemon cry.
+func GetCry(url string, conf *globals.Config) ([]byte, error) {
+	body, err := getBody(url, conf)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, fmt.Errorf("cry %w", err)
+		}
+		return nil, err
 	}
+	return body, nil
+}
 
-	return pokemon, nil
+// GetNames returns the localized names of any named resource, such as a
+// species, type or location area.
+func GetNames(url string, conf *globals.Config) ([]globals.Name, error) {
+	var resource struct {
+		Names []globals.Name `json:"names"`
+	}
+	if err := getJSON(url, conf, &resource); err != nil {
+		return nil, err
+	}
+	return resource.Names, nil
 }
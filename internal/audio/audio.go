@@ -0,0 +1,103 @@
+package audio
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"unicode"
+)
+
+// Player plays an audio file.
+type Player interface {
+	Play(data []byte) error
+}
+
+// Command plays audio by running a command on a temporary copy of the file.
+// The path of the file replaces any {} argument, or is appended otherwise,
+// e.g. "ffplay -nodisp -autoexit" or "mpv --no-video {}".
+type Command struct {
+	Name string
+	Args []string
+	// Ext is the extension of the temporary file, e.g. ".ogg", for players
+	// that go by it.
+	Ext string
+}
+
+// NewCommand splits a command line on spaces into a Command. Single or
+// double quotes keep spaces in an argument, e.g. "'/opt/my player/play' {}".
+func NewCommand(cmdline, ext string) (Command, error) {
+	fields, err := splitCommand(cmdline)
+	if err != nil {
+		return Command{}, err
+	}
+	if len(fields) == 0 {
+		return Command{}, fmt.Errorf("empty player command")
+	}
+	return Command{Name: fields[0], Args: fields[1:], Ext: ext}, nil
+}
+
+// splitCommand splits cmdline on spaces outside of quotes, dropping the
+// quotes.
+func splitCommand(cmdline string) ([]string, error) {
+	fields := []string{}
+	var field strings.Builder
+	inField := false
+	var quote rune
+	for _, c := range cmdline {
+		switch {
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote != 0:
+			field.WriteRune(c)
+		case c == '\'' || c == '"':
+			quote, inField = c, true
+		case unicode.IsSpace(c):
+			if inField {
+				fields = append(fields, field.String())
+				field.Reset()
+				inField = false
+			}
+		default:
+			field.WriteRune(c)
+			inField = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote in player command", quote)
+	}
+	if inField {
+		fields = append(fields, field.String())
+	}
+	return fields, nil
+}
+
+func (c Command) Play(data []byte) error {
+	file, err := os.CreateTemp("", "pokedex-*"+c.Ext)
+	if err != nil {
+		return fmt.Errorf("could not create audio file - %w", err)
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return fmt.Errorf("could not write audio file - %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("could not write audio file - %w", err)
+	}
+
+	args, replaced := []string{}, false
+	for _, arg := range c.Args {
+		if arg == "{}" {
+			arg, replaced = file.Name(), true
+		}
+		args = append(args, arg)
+	}
+	if !replaced {
+		args = append(args, file.Name())
+	}
+	if out, err := exec.Command(c.Name, args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("could not play audio with %s - %w: %s", c.Name, err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
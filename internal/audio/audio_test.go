@@ -0,0 +1,74 @@
+package audio
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestHelperPlayer isn't a real test: TestCommandPlay runs the test binary as
+// a player, which copies the audio file to the file after it.
+func TestHelperPlayer(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") != "1" {
+		return
+	}
+	args := os.Args
+	for len(args) > 0 && args[0] != "--" {
+		args = args[1:]
+	}
+	if len(args) != 3 {
+		fmt.Fprintln(os.Stderr, "usage: -- <audio file> <copy>")
+		os.Exit(2)
+	}
+	data, err := os.ReadFile(args[1])
+	if err == nil {
+		err = os.WriteFile(args[2], data, 0o644)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+func TestCommandPlay(t *testing.T) {
+	t.Setenv("GO_WANT_HELPER_PROCESS", "1")
+	helper := os.Args[0] + " -test.run=^TestHelperPlayer$ --"
+	dest := filepath.Join(t.TempDir(), "my cries", "cry.ogg")
+	if err := os.Mkdir(filepath.Dir(dest), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		cmdline string
+		valid   bool
+	}{
+		{cmdline: helper + " {} '" + dest + "'", valid: true},
+		{cmdline: helper + ` {} "` + dest + `"`, valid: true},
+		{cmdline: helper + " {} " + dest, valid: false},
+		{cmdline: helper + " {} '" + dest, valid: false},
+		{cmdline: helper, valid: false},
+		{cmdline: "no-such-player-command", valid: false},
+		{cmdline: " ", valid: false},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			player, err := NewCommand(c.cmdline, ".ogg")
+			if err == nil {
+				err = player.Play([]byte("OggS"))
+			}
+			if c.valid != (err == nil) {
+				t.Errorf("expected valid to be %v, got error %v", c.valid, err)
+				return
+			}
+			if !c.valid {
+				return
+			}
+			data, err := os.ReadFile(dest)
+			if err != nil || string(data) != "OggS" {
+				t.Errorf("expected the audio to be passed to the command, got %q (%v)", data, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,293 @@
+package battle
+
+import (
+	"math/rand"
+	"slices"
+)
+
+// Stats are the six stats of a pokemon, either base stats or the actual
+// stats at a level.
+type Stats struct {
+	HP             int `json:"hp"`
+	Attack         int `json:"attack"`
+	Defense        int `json:"defense"`
+	SpecialAttack  int `json:"special_attack"`
+	SpecialDefense int `json:"special_defense"`
+	Speed          int `json:"speed"`
+}
+
+// StatsAt returns the stats at level of a pokemon with the given base stats,
+// leaving out individual values, effort values and nature.
+func StatsAt(base Stats, level int) Stats {
+	stat := func(b int) int { return 2*b*level/100 + 5 }
+	return Stats{
+		HP:             2*base.HP*level/100 + level + 10,
+		Attack:         stat(base.Attack),
+		Defense:        stat(base.Defense),
+		SpecialAttack:  stat(base.SpecialAttack),
+		SpecialDefense: stat(base.SpecialDefense),
+		Speed:          stat(base.Speed),
+	}
+}
+
+// Move is what a pokemon needs to know about a move to use it in battle.
+type Move struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	// DamageClass is physical, special or status.
+	DamageClass string `json:"damage_class"`
+	Power       int    `json:"power"`
+	// Accuracy is 0 for moves that never miss.
+	Accuracy int `json:"accuracy"`
+	// Ailment is the status condition the move inflicts, if any. Status
+	// moves always inflict it, damaging moves with AilmentChance percent.
+	Ailment       string `json:"ailment,omitempty"`
+	AilmentChance int    `json:"ailment_chance,omitempty"`
+}
+
+// Statuses are the status conditions a pokemon keeps after a battle, of
+// which it can only have one at a time.
+var Statuses = []string{"burn", "freeze", "paralysis", "poison", "sleep"}
+
+// IsStatus reports whether ailment is one of Statuses.
+func IsStatus(ailment string) bool {
+	return slices.Contains(Statuses, ailment)
+}
+
+// Struggle is used by a pokemon that knows no damaging move. It has no type,
+// so it hits every pokemon normally.
+var Struggle = Move{Name: "struggle", DamageClass: "physical", Power: 50}
+
+// Combatant is a pokemon taking part in a battle.
+type Combatant struct {
+	Name  string
+	Level int
+	Types []string
+	Stats Stats
+	HP    int
+	Moves []Move
+	// Status is the combatant's status condition, "" if it has none.
+	Status string
+}
+
+// Fainted reports whether the combatant has no HP left.
+func (c Combatant) Fainted() bool {
+	return c.HP <= 0
+}
+
+// Damaging returns the moves that deal damage, or Struggle if there are
+// none.
+func (c Combatant) Damaging() []Move {
+	moves := []Move{}
+	for _, move := range c.Moves {
+		if move.Power > 0 && move.DamageClass != "status" {
+			moves = append(moves, move)
+		}
+	}
+	if len(moves) == 0 {
+		return []Move{Struggle}
+	}
+	return moves
+}
+
+// Usable returns the moves the combatant can be told to use: its damaging
+// moves and the status moves inflicting a status condition.
+func (c Combatant) Usable() []Move {
+	moves := c.Damaging()
+	for _, move := range c.Moves {
+		if move.DamageClass == "status" && move.Ailment != "" {
+			moves = append(moves, move)
+		}
+	}
+	return moves
+}
+
+func (c Combatant) hasType(name string) bool {
+	for _, t := range c.Types {
+		if t == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Chart maps an attacking type to the multiplier its moves do against each
+// defending type. Pairs that are missing do normal damage.
+type Chart map[string]map[string]float64
+
+// Effectiveness returns the multiplier of a move of type moveType against a
+// pokemon with the given types, multiplied over dual types.
+func (c Chart) Effectiveness(moveType string, defender []string) float64 {
+	multiplier := 1.0
+	for _, t := range defender {
+		if m, ok := c[moveType][t]; ok {
+			multiplier *= m
+		}
+	}
+	return multiplier
+}
+
+// Hit is what happened when one pokemon attacked.
+type Hit struct {
+	Attacker string `json:"attacker"`
+	Move     string `json:"move"`
+	// Skipped is the status condition that kept the attacker from moving,
+	// if any. Cured is the one it recovered from before moving.
+	Skipped       string  `json:"skipped,omitempty"`
+	Cured         string  `json:"cured,omitempty"`
+	Missed        bool    `json:"missed"`
+	Critical      bool    `json:"critical"`
+	Effectiveness float64 `json:"effectiveness"`
+	Damage        int     `json:"damage"`
+	// Status is the status condition the hit inflicted, if any. Failed is
+	// set when a status move hit a pokemon that already had one.
+	Status string `json:"status,omitempty"`
+	Failed bool   `json:"failed,omitempty"`
+}
+
+// criticalChance is the 1 in n chance of a critical hit.
+const criticalChance = 24
+
+// Chances in percent of a status condition wearing off, or of paralysis
+// keeping a pokemon from moving, each turn.
+const (
+	wakeChance      = 33
+	thawChance      = 20
+	paralysisChance = 25
+)
+
+// Attack makes attacker use move on defender and takes the damage off the
+// defender's HP, using the Generation V+ damage formula, or gives the
+// defender the status condition of a status move. A sleeping, frozen or
+// paralyzed attacker may not get to move at all.
+func Attack(attacker, defender *Combatant, move Move, chart Chart, r *rand.Rand) Hit {
+	hit := Hit{Attacker: attacker.Name, Move: move.Name}
+	if hit.Skipped, hit.Cured = checkStatus(attacker, r); hit.Skipped != "" {
+		return hit
+	}
+	if move.Accuracy > 0 && r.Intn(100) >= move.Accuracy {
+		hit.Missed = true
+		return hit
+	}
+
+	hit.Effectiveness = chart.Effectiveness(move.Type, defender.Types)
+	if hit.Effectiveness == 0 {
+		return hit
+	}
+	if move.DamageClass == "status" {
+		if defender.Status != "" {
+			hit.Failed = true
+			return hit
+		}
+		defender.Status, hit.Status = move.Ailment, move.Ailment
+		return hit
+	}
+
+	attack, defense := attacker.Stats.Attack, defender.Stats.Defense
+	if move.DamageClass == "special" {
+		attack, defense = attacker.Stats.SpecialAttack, defender.Stats.SpecialDefense
+	}
+	base := (2*attacker.Level/5+2)*move.Power*attack/max(defense, 1)/50 + 2
+
+	modifier := hit.Effectiveness * float64(85+r.Intn(16)) / 100
+	if attacker.hasType(move.Type) {
+		modifier *= 1.5
+	}
+	if r.Intn(criticalChance) == 0 {
+		hit.Critical = true
+		modifier *= 1.5
+	}
+	if attacker.Status == "burn" && move.DamageClass == "physical" {
+		modifier *= 0.5
+	}
+
+	hit.Damage = max(int(float64(base)*modifier), 1)
+	defender.HP = max(defender.HP-hit.Damage, 0)
+	if move.Ailment != "" && defender.Status == "" && !defender.Fainted() && r.Intn(100) < move.AilmentChance {
+		defender.Status, hit.Status = move.Ailment, move.Ailment
+	}
+	return hit
+}
+
+// checkStatus returns the status condition keeping c from moving this turn,
+// or the one c recovered from, taking it off c.
+func checkStatus(c *Combatant, r *rand.Rand) (skipped, cured string) {
+	recovers := 0
+	switch c.Status {
+	case "sleep":
+		recovers = wakeChance
+	case "freeze":
+		recovers = thawChance
+	case "paralysis":
+		if r.Intn(100) < paralysisChance {
+			return c.Status, ""
+		}
+		return "", ""
+	default:
+		return "", ""
+	}
+	if r.Intn(100) >= recovers {
+		return c.Status, ""
+	}
+	cured, c.Status = c.Status, ""
+	return "", cured
+}
+
+// StatusDamage takes the damage a burn or poison does at the end of a turn
+// off the HP of c, an eighth of its HP for poison and a sixteenth for a
+// burn, and returns it.
+func StatusDamage(c *Combatant) int {
+	damage := 0
+	switch c.Status {
+	case "burn":
+		damage = max(c.Stats.HP/16, 1)
+	case "poison":
+		damage = max(c.Stats.HP/8, 1)
+	}
+	damage = min(damage, c.HP)
+	c.HP -= damage
+	return damage
+}
+
+// BestMove returns the damaging move of attacker expected to hurt defender
+// the most.
+func BestMove(attacker, defender Combatant, chart Chart) Move {
+	var best Move
+	bestScore := -1.0
+	for _, move := range attacker.Damaging() {
+		score := float64(move.Power) * chart.Effectiveness(move.Type, defender.Types)
+		if move.Accuracy > 0 {
+			score *= float64(move.Accuracy) / 100
+		}
+		if attacker.hasType(move.Type) {
+			score *= 1.5
+		}
+		if score > bestScore {
+			best, bestScore = move, score
+		}
+	}
+	return best
+}
+
+// RandomMove picks one of the damaging moves of c at random, the way wild
+// pokemon fight.
+func RandomMove(c Combatant, r *rand.Rand) Move {
+	moves := c.Damaging()
+	return moves[r.Intn(len(moves))]
+}
+
+// First reports whether a moves before b, the faster one going first and a
+// tie going either way. Paralysis halves a pokemon's speed.
+func First(a, b Combatant, r *rand.Rand) bool {
+	if a.speed() != b.speed() {
+		return a.speed() > b.speed()
+	}
+	return r.Intn(2) == 0
+}
+
+func (c Combatant) speed() int {
+	if c.Status == "paralysis" {
+		return c.Stats.Speed / 2
+	}
+	return c.Stats.Speed
+}
@@ -0,0 +1,202 @@
+package battle
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+var testChart = Chart{
+	"electric": {"water": 2, "flying": 2, "ground": 0, "grass": 0.5},
+	"water":    {"fire": 2, "grass": 0.5},
+}
+
+func TestStatsAt(t *testing.T) {
+	pikachu := Stats{HP: 35, Attack: 55, Defense: 40, SpecialAttack: 50, SpecialDefense: 50, Speed: 90}
+	expected := Stats{HP: 95, Attack: 60, Defense: 45, SpecialAttack: 55, SpecialDefense: 55, Speed: 95}
+	if got := StatsAt(pikachu, 50); got != expected {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestEffectiveness(t *testing.T) {
+	cases := []struct {
+		moveType string
+		defender []string
+		expected float64
+	}{
+		{moveType: "electric", defender: []string{"water"}, expected: 2},
+		{moveType: "electric", defender: []string{"water", "flying"}, expected: 4},
+		{moveType: "electric", defender: []string{"water", "grass"}, expected: 1},
+		{moveType: "electric", defender: []string{"ground", "flying"}, expected: 0},
+		{moveType: "normal", defender: []string{"water"}, expected: 1},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			if got := testChart.Effectiveness(c.moveType, c.defender); got != c.expected {
+				t.Errorf("expected %v, got %v", c.expected, got)
+			}
+		})
+	}
+}
+
+func TestAttack(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	thunderShock := Move{Name: "thunder-shock", Type: "electric", DamageClass: "special", Power: 40}
+	pikachu := Combatant{Name: "pikachu", Level: 10, Types: []string{"electric"}, Stats: Stats{SpecialAttack: 20}, Moves: []Move{thunderShock}}
+
+	for i := 0; i < 100; i++ {
+		geodude := Combatant{Name: "geodude", Types: []string{"rock", "ground"}, Stats: Stats{SpecialDefense: 15}, HP: 30}
+		if hit := Attack(&pikachu, &geodude, thunderShock, testChart, r); hit.Damage != 0 || geodude.HP != 30 {
+			t.Errorf("expected ground types to be immune, got %+v", hit)
+			return
+		}
+
+		magikarp := Combatant{Name: "magikarp", Types: []string{"water"}, Stats: Stats{SpecialDefense: 15}, HP: 30}
+		hit := Attack(&pikachu, &magikarp, thunderShock, testChart, r)
+		if hit.Damage < 1 || magikarp.HP != max(30-hit.Damage, 0) {
+			t.Errorf("expected the damage to be taken off the HP, got %+v and %v HP", hit, magikarp.HP)
+			return
+		}
+	}
+}
+
+func TestAttackStatus(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	thunderWave := Move{Name: "thunder-wave", Type: "electric", DamageClass: "status", Ailment: "paralysis"}
+	pikachu := Combatant{Name: "pikachu", Types: []string{"electric"}, Moves: []Move{thunderWave}}
+
+	magikarp := Combatant{Name: "magikarp", Types: []string{"water"}, HP: 30}
+	if hit := Attack(&pikachu, &magikarp, thunderWave, testChart, r); hit.Status != "paralysis" || magikarp.Status != "paralysis" || magikarp.HP != 30 {
+		t.Errorf("expected magikarp to be paralyzed without damage, got %+v and %+v", hit, magikarp)
+	}
+	if hit := Attack(&pikachu, &magikarp, thunderWave, testChart, r); !hit.Failed || hit.Status != "" {
+		t.Errorf("expected a second status to fail, got %+v", hit)
+	}
+
+	geodude := Combatant{Name: "geodude", Types: []string{"rock", "ground"}, HP: 30}
+	if hit := Attack(&pikachu, &geodude, thunderWave, testChart, r); hit.Effectiveness != 0 || geodude.Status != "" {
+		t.Errorf("expected ground types to be immune, got %+v", hit)
+	}
+
+	thunderShock := Move{Name: "thunder-shock", Type: "electric", DamageClass: "special", Power: 40, Ailment: "paralysis", AilmentChance: 100}
+	pikachu.Stats.SpecialAttack = 20
+	magikarp = Combatant{Name: "magikarp", Types: []string{"water"}, Stats: Stats{SpecialDefense: 15}, HP: 100}
+	if hit := Attack(&pikachu, &magikarp, thunderShock, testChart, r); hit.Damage < 1 || hit.Status != "paralysis" {
+		t.Errorf("expected damage and paralysis, got %+v", hit)
+	}
+
+	if got := pikachu.Usable(); len(got) != 2 || got[0].Name != Struggle.Name || got[1].Name != thunderWave.Name {
+		t.Errorf("expected struggle and thunder-wave to be usable, got %+v", got)
+	}
+}
+
+func TestBestMove(t *testing.T) {
+	tackle := Move{Name: "tackle", Type: "normal", DamageClass: "physical", Power: 40, Accuracy: 100}
+	bubble := Move{Name: "bubble", Type: "water", DamageClass: "special", Power: 40, Accuracy: 100}
+	growl := Move{Name: "growl", Type: "normal", DamageClass: "status", Accuracy: 100}
+
+	squirtle := Combatant{Types: []string{"water"}, Moves: []Move{tackle, growl, bubble}}
+	if got := BestMove(squirtle, Combatant{Types: []string{"fire"}}, testChart); got.Name != "bubble" {
+		t.Errorf("expected bubble against a fire type, got %v", got.Name)
+	}
+	if got := BestMove(squirtle, Combatant{Types: []string{"grass"}}, testChart); got.Name != "tackle" {
+		t.Errorf("expected tackle against a grass type, got %v", got.Name)
+	}
+	if got := BestMove(Combatant{Moves: []Move{growl}}, squirtle, testChart); got.Name != Struggle.Name {
+		t.Errorf("expected struggle without damaging moves, got %v", got.Name)
+	}
+}
+
+func TestSleepAndFreeze(t *testing.T) {
+	tackle := Move{Name: "tackle", Type: "normal", DamageClass: "physical", Power: 40}
+	for _, status := range []string{"sleep", "freeze"} {
+		r := rand.New(rand.NewSource(1))
+		rattata := Combatant{Name: "rattata", Stats: Stats{Attack: 20}, Status: status}
+		skipped := 0
+		for rattata.Status != "" {
+			pidgey := Combatant{Name: "pidgey", Stats: Stats{Defense: 15}, HP: 30}
+			hit := Attack(&rattata, &pidgey, tackle, testChart, r)
+			if rattata.Status != "" {
+				if hit.Skipped != status || pidgey.HP != 30 {
+					t.Errorf("expected %s to keep rattata from moving, got %+v", status, hit)
+					return
+				}
+				skipped++
+				continue
+			}
+			if hit.Cured != status || hit.Damage < 1 {
+				t.Errorf("expected rattata to recover from %s and attack, got %+v", status, hit)
+			}
+		}
+		if skipped == 0 {
+			t.Errorf("expected %s to keep rattata from moving at first", status)
+		}
+	}
+}
+
+func TestParalysis(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tackle := Move{Name: "tackle", Type: "normal", DamageClass: "physical", Power: 40}
+	rattata := Combatant{Name: "rattata", Stats: Stats{Attack: 20, Speed: 100}, Status: "paralysis"}
+	pidgey := Combatant{Name: "pidgey", Stats: Stats{Defense: 15, Speed: 60}, HP: 10000}
+
+	skipped := 0
+	for i := 0; i < 100; i++ {
+		hit := Attack(&rattata, &pidgey, tackle, testChart, r)
+		if hit.Skipped == "paralysis" {
+			skipped++
+		}
+	}
+	if skipped == 0 || skipped == 100 || rattata.Status != "paralysis" {
+		t.Errorf("expected paralysis to keep rattata from moving some of the time, skipped %d turns", skipped)
+	}
+	if First(rattata, pidgey, r) {
+		t.Errorf("expected paralysis to make rattata slower than pidgey")
+	}
+}
+
+func TestBurn(t *testing.T) {
+	tackle := Move{Name: "tackle", Type: "normal", DamageClass: "physical", Power: 40}
+	ember := Move{Name: "ember", Type: "fire", DamageClass: "special", Power: 40}
+	for _, move := range []Move{tackle, ember} {
+		damage := map[string]int{}
+		for _, status := range []string{"", "burn"} {
+			r := rand.New(rand.NewSource(1))
+			charmander := Combatant{Name: "charmander", Stats: Stats{Attack: 50, SpecialAttack: 50}, Status: status}
+			bulbasaur := Combatant{Name: "bulbasaur", Stats: Stats{Defense: 10, SpecialDefense: 10}, HP: 10000}
+			damage[status] = Attack(&charmander, &bulbasaur, move, testChart, r).Damage
+		}
+		halved := damage["burn"]*2 - damage[""]
+		if move.DamageClass == "physical" && (halved < -1 || halved > 1) {
+			t.Errorf("expected a burn to halve the damage of %s, got %v", move.Name, damage)
+		}
+		if move.DamageClass == "special" && damage["burn"] != damage[""] {
+			t.Errorf("expected a burn not to change the damage of %s, got %v", move.Name, damage)
+		}
+	}
+}
+
+func TestStatusDamage(t *testing.T) {
+	cases := []struct {
+		status   string
+		hp       int
+		expected int
+	}{
+		{status: "burn", hp: 160, expected: 10},
+		{status: "poison", hp: 160, expected: 20},
+		{status: "poison", hp: 5, expected: 5},
+		{status: "paralysis", hp: 160, expected: 0},
+		{status: "", hp: 160, expected: 0},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			pokemon := Combatant{Stats: Stats{HP: 160}, HP: c.hp, Status: c.status}
+			if got := StatusDamage(&pokemon); got != c.expected || pokemon.HP != c.hp-c.expected {
+				t.Errorf("expected %d damage, got %d and %d HP left", c.expected, got, pokemon.HP)
+			}
+		})
+	}
+}
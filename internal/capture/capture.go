@@ -0,0 +1,97 @@
+package capture
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Shakes is the number of shake checks a ball makes; passing all of them
+// catches the pokemon.
+const Shakes = 4
+
+// Params are the inputs of the Generation III/IV catch formula.
+type Params struct {
+	// CaptureRate is the species' capture_rate, 3 for legendaries up to 255.
+	CaptureRate int
+	// HP and MaxHP are the wild pokemon's current and maximum hit points.
+	// Leaving both zero means full health.
+	HP    int
+	MaxHP int
+	// BallBonus multiplies the catch rate, 1 for a Poke Ball.
+	BallBonus float64
+	// Guaranteed is set for balls that never fail, like the Master Ball.
+	Guaranteed bool
+	// Status is the wild pokemon's status condition, "" if it has none.
+	Status string
+}
+
+// StatusBonus returns the catch rate multiplier of a status condition.
+func StatusBonus(status string) float64 {
+	switch status {
+	case "sleep", "freeze":
+		return 2
+	case "paralysis", "poison", "burn":
+		return 1.5
+	default:
+		return 1
+	}
+}
+
+// ModifiedRate returns the modified catch rate a. A pokemon is caught
+// outright when a reaches 255.
+func ModifiedRate(p Params) float64 {
+	if p.Guaranteed {
+		return 255
+	}
+	maxHP, hp := float64(p.MaxHP), float64(p.HP)
+	if p.MaxHP <= 0 {
+		maxHP, hp = 1, 1
+	}
+	hp = max(hp, 1)
+
+	a := (3*maxHP - 2*hp) * float64(p.CaptureRate) * p.BallBonus / (3 * maxHP) * StatusBonus(p.Status)
+	return min(max(a, 1), 255)
+}
+
+// ShakeThreshold returns b: each shake check passes when a random number
+// in [0, 65536) is below it.
+func ShakeThreshold(p Params) int {
+	a := ModifiedRate(p)
+	if a >= 255 {
+		return 65536
+	}
+	return int(1048560 / math.Sqrt(math.Sqrt(16711680/a)))
+}
+
+// Probability returns the chance, between 0 and 1, that a throw catches the
+// pokemon.
+func Probability(p Params) float64 {
+	return math.Pow(float64(ShakeThreshold(p))/65536, Shakes)
+}
+
+// Result is the outcome of one throw.
+type Result struct {
+	// Shakes is how many shake checks passed before the pokemon broke free,
+	// Shakes if it was caught.
+	Shakes int
+	Caught bool
+	// Threshold is the shake threshold b and Rolls are the random numbers
+	// drawn for the shake checks, each passing when it is below Threshold.
+	Threshold int
+	Rolls     []int
+}
+
+// Attempt throws a ball, making shake checks until one fails.
+func Attempt(p Params, r *rand.Rand) Result {
+	res := Result{Threshold: ShakeThreshold(p), Rolls: []int{}}
+	for res.Shakes < Shakes {
+		roll := r.Intn(65536)
+		res.Rolls = append(res.Rolls, roll)
+		if roll >= res.Threshold {
+			return res
+		}
+		res.Shakes++
+	}
+	res.Caught = true
+	return res
+}
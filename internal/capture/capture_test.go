@@ -0,0 +1,72 @@
+package capture
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestProbability(t *testing.T) {
+	cases := []struct {
+		params   Params
+		expected float64
+	}{
+		{
+			// A full health Mewtwo in a Poke Ball.
+			params:   Params{CaptureRate: 3, BallBonus: 1},
+			expected: 0.0039,
+		},
+		{
+			// A full health Pikachu in a Poke Ball.
+			params:   Params{CaptureRate: 190, HP: 35, MaxHP: 35, BallBonus: 1},
+			expected: 0.2483,
+		},
+		{
+			// Weakened and asleep it is a lot easier.
+			params:   Params{CaptureRate: 190, HP: 1, MaxHP: 35, BallBonus: 1.5, Status: "sleep"},
+			expected: 1,
+		},
+		{
+			params:   Params{CaptureRate: 3, BallBonus: 1, Guaranteed: true},
+			expected: 1,
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			got := Probability(c.params)
+			if math.Abs(got-c.expected) > 0.0001 {
+				t.Errorf("expected probability %.4f, got %.4f", c.expected, got)
+			}
+		})
+	}
+}
+
+func TestAttempt(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	params := Params{CaptureRate: 45, BallBonus: 1}
+
+	caught := 0
+	const throws = 10000
+	for i := 0; i < throws; i++ {
+		res := Attempt(params, r)
+		if res.Caught != (res.Shakes == Shakes) {
+			t.Errorf("expected caught only after %d shakes, got %+v", Shakes, res)
+			return
+		}
+		// Every roll but a failing last one is below the threshold.
+		if len(res.Rolls) != min(res.Shakes+1, Shakes) || (!res.Caught && res.Rolls[res.Shakes] < res.Threshold) {
+			t.Errorf("expected the rolls to explain the shakes, got %+v", res)
+			return
+		}
+		if res.Caught {
+			caught++
+		}
+	}
+
+	got := float64(caught) / throws
+	if math.Abs(got-Probability(params)) > 0.02 {
+		t.Errorf("expected about %.3f of throws to catch, got %.3f", Probability(params), got)
+	}
+}
@@ -0,0 +1,68 @@
+package fuzzy
+
+import (
+	"sort"
+	"strings"
+)
+
+// Score rates how well candidate matches query, case-insensitively. A
+// candidate containing the query as a substring always beats one that only
+// contains its characters in order; within each kind, earlier and tighter
+// matches score higher, then shorter candidates. ok is false if candidate
+// does not match at all.
+func Score(query, candidate string) (score int, ok bool) {
+	query, candidate = strings.ToLower(query), strings.ToLower(candidate)
+	if query == "" {
+		return 0, true
+	}
+
+	if i := strings.Index(candidate, query); i >= 0 {
+		return substringBonus - i*weight - len(candidate), true
+	}
+
+	// Subsequence match: every query character in order, scored by how
+	// spread out the matched characters are.
+	start, pos := -1, 0
+	for _, r := range query {
+		i := strings.IndexRune(candidate[pos:], r)
+		if i < 0 {
+			return 0, false
+		}
+		if start < 0 {
+			start = pos + i
+		}
+		pos += i + len(string(r))
+	}
+	span := pos - start
+	return -(span+start)*weight - len(candidate), true
+}
+
+const (
+	// substringBonus puts every substring match above every subsequence match.
+	substringBonus = 1 << 30
+	// weight makes match position count for more than candidate length.
+	weight = 1 << 10
+)
+
+// Filter returns the candidates matching query, best match first.
+func Filter(query string, candidates []string) []string {
+	type match struct {
+		candidate string
+		score     int
+	}
+	matches := []match{}
+	for _, candidate := range candidates {
+		if score, ok := Score(query, candidate); ok {
+			matches = append(matches, match{candidate: candidate, score: score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+
+	results := make([]string, 0, len(matches))
+	for _, m := range matches {
+		results = append(results, m.candidate)
+	}
+	return results
+}
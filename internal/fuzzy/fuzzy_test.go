@@ -0,0 +1,49 @@
+package fuzzy
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestFilter(t *testing.T) {
+	candidates := []string{"canalave-city-area", "eterna-forest-area", "mt-coronet-1f-route-207", "lake-verity-front"}
+	cases := []struct {
+		query    string
+		expected []string
+	}{
+		{
+			query:    "city",
+			expected: []string{"canalave-city-area"},
+		},
+		{
+			query:    "Forest",
+			expected: []string{"eterna-forest-area"},
+		},
+		{
+			query:    "lkvr",
+			expected: []string{"lake-verity-front"},
+		},
+		{
+			query:    "area",
+			expected: []string{"canalave-city-area", "eterna-forest-area"},
+		},
+		{
+			query:    "re",
+			expected: []string{"eterna-forest-area", "canalave-city-area", "mt-coronet-1f-route-207"},
+		},
+		{
+			query:    "zubat",
+			expected: []string{},
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			got := Filter(c.query, candidates)
+			if !reflect.DeepEqual(got, c.expected) {
+				t.Errorf("expected %v, got %v", c.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package i18n
+
+// de translates the messages to German.
+var de = map[string]string{
+	"page %d of %d":                          "Seite %d von %d",
+	"Exploring %s...":                        "Erkunde %s...",
+	"Version: %s":                            "Edition: %s",
+	"Found Pokemon:":                         "Gefundene Pokemon:",
+	"Encounter rates:":                       "Begegnungsraten:",
+	"Pokedex is empty!":                      "Der Pokedex ist leer!",
+	"Name: %s":                               "Name: %s",
+	"Genus: %s":                              "Kategorie: %s",
+	"Form: %s":                               "Form: %s",
+	"Level: %v":                              "Level: %v",
+	"Height: %v":                             "Größe: %v",
+	"Weight: %v":                             "Gewicht: %v",
+	"Stats:":                                 "Werte:",
+	"Types:":                                 "Typen:",
+	"Abilities:":                             "Fähigkeiten:",
+	"(hidden)":                               "(versteckt)",
+	"Pokedex entry (%s):":                    "Pokedex-Eintrag (%s):",
+	"Habitat: %s":                            "Lebensraum: %s",
+	"Color: %s":                              "Farbe: %s",
+	"Shape: %s":                              "Körperform: %s",
+	"Generation: %s":                         "Generation: %s",
+	"Gender: %s":                             "Geschlecht: %s",
+	"genderless":                             "geschlechtslos",
+	"%g%% female, %g%% male":                 "%g%% weiblich, %g%% männlich",
+	"Legendary Pokemon":                      "Legendäres Pokemon",
+	"Mythical Pokemon":                       "Mysteriöses Pokemon",
+	"Some names could not be translated: %s": "Einige Namen konnten nicht übersetzt werden: %s",
+	"Could not perform command: %v":          "Befehl fehlgeschlagen: %v",
+	"Unknown command. Type 'help' for a list of commands.": "Unbekannter Befehl. Gib 'help' ein, um alle Befehle zu sehen.",
+	"Exiting":                         "Beende",
+	"Already on the last page":        "Schon auf der letzten Seite",
+	"Already on Page 1":               "Schon auf Seite 1",
+	"Capture rate of %s: %v":          "Fangrate von %s: %v",
+	"Chance of success: %.1f percent": "Erfolgschance: %.1f Prozent",
+	"Throwing a %s at %s...":          "Wirf einen %s auf %s...",
+	"Result: %s You caught %v!":       "Ergebnis: %s Du hast %v gefangen!",
+	"Success!":                        "Erfolg!",
+	"Oh no!":                          "Oh nein!",
+	"Result: %s %v slipped away!":     "Ergebnis: %s %v ist entkommen!",
+	"%s It's a shiny %s!":             "%s Es ist ein schillerndes %s!",
+	"%s left: %d":                     "%s übrig: %d",
+	"Current Pokedex:":                "Aktueller Pokedex:",
+	"You walk through %s...":          "Du läufst durch %s...",
+	"You search %s with %s...":        "Du durchsuchst %s mit %s...",
+	"Nothing appeared.":               "Nichts ist erschienen.",
+	"A wild %s (lv %d) appeared!":     "Ein wildes %s (Lv. %d) erscheint!",
+	"Go, %s!":                         "Los, %s!",
+	"%s leads your team.":             "%s führt dein Team an.",
+	"You have no lead pokemon yet - catch one first.": "Du hast noch kein Start-Pokemon - fange zuerst eins.",
+	"%s (lv %d) vs wild %s (lv %d)":                   "%s (Lv. %d) gegen wildes %s (Lv. %d)",
+	"%s used %s!":                                     "%s setzt %s ein!",
+	"%s used %s, but it missed!":                      "%s setzt %s ein, aber es ging daneben!",
+	"It doesn't affect %s...":                         "Es hat keine Wirkung auf %s...",
+	"A critical hit!":                                 "Ein Volltreffer!",
+	"It's super effective!":                           "Das ist sehr effektiv!",
+	"It's not very effective...":                      "Das ist nicht sehr effektiv...",
+	"%s lost %d HP.":                                  "%s verliert %d KP.",
+	"The wild %s fainted!":                            "Das wilde %s wurde besiegt!",
+	"You won!":                                        "Du hast gewonnen!",
+	"%s fainted!":                                     "%s wurde besiegt!",
+	"The wild %s got away.":                           "Das wilde %s ist entkommen.",
+	"But it failed!":                                  "Es schlug fehl!",
+	"%s was burned!":                                  "%s erleidet Verbrennungen!",
+	"%s was frozen solid!":                            "%s wurde eingefroren!",
+	"%s is paralyzed!":                                "%s ist paralysiert!",
+	"%s was poisoned!":                                "%s wurde vergiftet!",
+	"%s fell asleep!":                                 "%s ist eingeschlafen!",
+	"%s is frozen solid!":                             "%s ist eingefroren!",
+	"%s is paralyzed! It can't move!":                 "%s ist paralysiert! Es kann nicht angreifen!",
+	"%s is fast asleep.":                              "%s schläft tief und fest.",
+	"%s thawed out!":                                  "%s ist wieder aufgetaut!",
+	"%s woke up!":                                     "%s ist aufgewacht!",
+	"%s is hurt by its burn and lost %d HP.":          "%s wird durch die Verbrennung verletzt und verliert %d KP.",
+	"%s is hurt by poison and lost %d HP.":            "%s wird durch das Gift verletzt und verliert %d KP.",
+	"Your pokemon are fully healed.":                  "Deine Pokemon sind wieder topfit.",
+	"%s has fainted - use 'heal' or choose another lead with 'lead <pokemon>'": "%s ist besiegt - nutze 'heal' oder wähle ein anderes Start-Pokemon mit 'lead <pokemon>'",
+	"Keep fighting with 'fight [move]' or throw a ball with 'catch'.":          "Kämpfe weiter mit 'fight [move]' oder wirf einen Ball mit 'catch'.",
+	"Note: %s": "Hinweis: %s",
+	"cache_ttl applies the next time the Pokedex starts":          "cache_ttl gilt ab dem nächsten Start des Pokedex",
+	"%s is set and overrides this value on the next start":        "%s ist gesetzt und überschreibt diesen Wert beim nächsten Start",
+	"limit must be a positive number":                             "limit muss eine positive Zahl sein",
+	"unknown argument %q - expected first, last or search":        "unbekanntes Argument %q - erwartet first, last oder search",
+	"there are only %d pages":                                     "es gibt nur %d Seiten",
+	"page must be at least 1":                                     "die Seite muss mindestens 1 sein",
+	"missing argument - give an area or 'goto' one first":         "fehlendes Argument - gib ein Gebiet an oder gehe zuerst mit 'goto' zu einem",
+	"empty location given":                                        "leerer Ort angegeben",
+	"there is no wild pokemon here - use 'walk' to look for one":  "hier ist kein wildes Pokemon - suche mit 'walk' nach einem",
+	"there is no wild %s here, only a wild %s":                    "hier ist kein wildes %s, nur ein wildes %s",
+	"wild pokemon can't be caught in another form":                "wilde Pokemon können nicht in einer anderen Form gefangen werden",
+	"catch command missing arguments":                             "dem catch-Befehl fehlen Argumente",
+	"pokemon %s already in pokedex":                               "Pokemon %s ist schon im Pokedex",
+	"you have no %s left - see 'inventory'":                       "du hast keine %s mehr - siehe 'inventory'",
+	"unknown ball %q - expected one of %s":                        "unbekannter Ball %q - erwartet einer von %s",
+	"%s has no other forms":                                       "%s hat keine anderen Formen",
+	"%s has no form %s - expected one of %s":                      "%s hat keine Form %s - erwartet eine von %s",
+	"missing parameter":                                           "fehlender Parameter",
+	"you have not caught that pokemon":                            "dieses Pokemon hast du nicht gefangen",
+	"lang must be one of %s":                                      "lang muss eine von %s sein",
+	"you are not in an area - use 'goto <area>' first":            "du bist in keinem Gebiet - gehe zuerst mit 'goto <area>' in eines",
+	"there are no wild pokemon in %s":                             "in %s gibt es keine wilden Pokemon",
+	"no pokemon can be found with %s here - try --method %s":      "hier lassen sich mit %s keine Pokemon finden - versuche --method %s",
+	"you have not caught %s":                                      "du hast %s nicht gefangen",
+	"you have no pokemon to fight with - catch one first":         "du hast kein Pokemon zum Kämpfen - fange zuerst eins",
+	"you have no lead pokemon - choose one with 'lead <pokemon>'": "du hast kein Start-Pokemon - wähle eins mit 'lead <pokemon>'",
+	"%s can't attack with %s - it knows %s":                       "%s kann nicht mit %s angreifen - es kennt %s",
+	"missing subcommand - expected list, get or set":              "fehlender Unterbefehl - erwartet list, get oder set",
+	"missing setting name":                                        "fehlender Name der Einstellung",
+	"usage: config set <key> <value>":                             "Verwendung: config set <key> <value>",
+	"unknown subcommand %q - expected list, get or set":           "unbekannter Unterbefehl %q - erwartet list, get oder set",
+	" or ":            " oder ",
+	"%v, and %d more": "%v und %d weitere",
+	"Unknown command %q. Run '%s help' for a list of commands.":     "Unbekannter Befehl %q. Führe '%s help' aus, um alle Befehle zu sehen.",
+	"%d matches - explore an area by name or id":                    "%d Treffer - erkunde ein Gebiet per Name oder ID",
+	"1 match - explore an area by name or id":                       "1 Treffer - erkunde ein Gebiet per Name oder ID",
+	"%d more not shown - narrow the search or raise page_size":      "%d weitere nicht angezeigt - verfeinere die Suche oder erhöhe page_size",
+	"No location areas match %q.":                                   "Keine Gebiete passen zu %q.",
+	"%s Your %s evolved into %s!":                                   "%s Dein %s hat sich zu %s entwickelt!",
+	"What? %s is evolving!":                                         "Nanu? %s entwickelt sich!",
+	"Congratulations!":                                              "Glückwunsch!",
+	"Evolutions of %s:":                                             "Entwicklungen von %s:",
+	"%s can be found in:":                                           "%s ist zu finden in:",
+	"%s can't be found in the wild in %s.":                          "%s ist in %s nicht in freier Wildbahn zu finden.",
+	"%s can't be found in the wild.":                                "%s ist nicht in freier Wildbahn zu finden.",
+	"%s can evolve into %s - choose one with 'evolve %s <pokemon>'": "%s kann sich zu %s entwickeln - wähle eines mit 'evolve %s <pokemon>'",
+	"%s can't evolve yet: %s":                                       "%s kann sich noch nicht entwickeln: %s",
+	"%s does not evolve any further":                                "%s entwickelt sich nicht weiter",
+	"%s does not evolve into %s":                                    "%s entwickelt sich nicht zu %s",
+	"%s has no evolutions":                                          "%s hat keine Entwicklungen",
+	"%s has no such sprite":                                         "%s hat kein solches Sprite",
+	"%s has no such sprite in generation %s":                        "%s hat in Generation %s kein solches Sprite",
+	"%s moves:":                                                     "%s-Attacken:",
+	"%s type":                                                       "Typ %s",
+	"%s vs %s:":                                                     "%s gegen %s:",
+	"(%d version groups)":                                           "(%d Versionsgruppen)",
+	"(you are here)":                                                "(du bist hier)",
+	"Accuracy: %s":                                                  "Genauigkeit: %s",
+	"Category: %s":                                                  "Kategorie: %s",
+	"Effect: %s":                                                    "Effekt: %s",
+	"PP: %d":                                                        "AP: %d",
+	"Power: %s":                                                     "Stärke: %s",
+	"Priority: %+d":                                                 "Priorität: %+d",
+	"Type: %s":                                                      "Typ: %s",
+	"Introduced in: %s":                                             "Eingeführt in: %s",
+	"Pokemon with this ability:":                                    "Pokemon mit dieser Fähigkeit:",
+	"Bag:":                                                          "Beutel:",
+	"Location: %s":                                                  "Ort: %s",
+	"Region: %s":                                                    "Region: %s",
+	"Regions:":                                                      "Regionen:",
+	"Locations in %s:":                                              "Orte in %s:",
+	"Areas in %s:":                                                  "Gebiete in %s:",
+	"No regions found.":                                             "Keine Regionen gefunden.",
+	"No locations found.":                                           "Keine Orte gefunden.",
+	"No areas found.":                                               "Keine Gebiete gefunden.",
+	"Travelled to %s!":                                              "Du bist nach %s gereist!",
+	"You are in %s.":                                                "Du bist in %s.",
+	"You haven't travelled anywhere yet. Use 'goto <area>' to set out.": "Du bist noch nirgendwohin gereist. Brich mit 'goto <area>' auf.",
+	"Matchups of %s:":                     "Matchups von %s:",
+	"Weak to:":                            "Schwach gegen:",
+	"Resists:":                            "Resistent gegen:",
+	"Immune to:":                          "Immun gegen:",
+	"no effect":                           "keine Wirkung",
+	"normal damage":                       "normaler Schaden",
+	"not very effective":                  "nicht sehr effektiv",
+	"super effective":                     "sehr effektiv",
+	"Moves of %s in %s:":                  "Attacken von %s in %s:",
+	"Moves of %s:":                        "Attacken von %s:",
+	"No moves found.":                     "Keine Attacken gefunden.",
+	"Random seed: %d":                     "Zufallsstartwert: %d",
+	"Usage:":                              "Verwendung:",
+	"Welcome to the Pokedex!":             "Willkommen im Pokedex!",
+	"lv %d":                               "Lv. %d",
+	"lv %d-%d":                            "Lv. %d-%d",
+	"level %d":                            "Level %d",
+	"level up":                            "Levelaufstieg",
+	"use %s":                              "%s verwenden",
+	"trade":                               "Tausch",
+	"happiness %d+":                       "Zuneigung %d+",
+	"affection %d+":                       "Freundschaft %d+",
+	"beauty %d+":                          "Schönheit %d+",
+	"holding %s":                          "hält %s",
+	"knowing %s":                          "beherrscht %s",
+	"knowing a %s move":                   "beherrscht eine %s-Attacke",
+	"at %s":                               "bei %s",
+	"for %s":                              "gegen %s",
+	"with %s in the party":                "mit %s im Team",
+	"with a %s type in the party":         "mit einem %s-Pokemon im Team",
+	"female":                              "weiblich",
+	"male":                                "männlich",
+	"attack > defense":                    "Angriff > Verteidigung",
+	"attack < defense":                    "Angriff < Verteidigung",
+	"in the rain":                         "im Regen",
+	"upside down":                         "kopfüber",
+	"day":                                 "Tag",
+	"dusk":                                "Abenddämmerung",
+	"night":                               "Nacht",
+	"needs %s, which your bag can't hold": "braucht %s, das dein Beutel nicht aufnehmen kann",
+	"needs happiness %d (it has %d)":      "braucht Zuneigung %d (hat %d)",
+	"needs level %d (it is level %d)":     "braucht Level %d (ist Level %d)",
+	"needs level %d, but only pokemon caught in game mode have a level": "braucht Level %d, aber nur im Spielmodus gefangene Pokemon haben ein Level",
+	"needs to %s": "muss: %s",
+	"needs to %s, which the Pokedex can't check":                        "muss: %s, was der Pokedex nicht prüfen kann",
+	"needs to be traded":                                                "muss getauscht werden",
+	"needs to know %s":                                                  "muss %s beherrschen",
+	"needs to level up at %s":                                           "muss bei %s ein Level aufsteigen",
+	"could not add %s to pokedex - %w":                                  "%s konnte nicht zum Pokedex hinzugefügt werden - %w",
+	"could not download sprite - %w":                                    "Sprite konnte nicht heruntergeladen werden - %w",
+	"could not encode result as json - %w":                              "Ergebnis konnte nicht als JSON kodiert werden - %w",
+	"could not explore area - %w":                                       "Gebiet konnte nicht erkundet werden - %w",
+	"could not fetch location area index - %w":                          "Gebietsverzeichnis konnte nicht geladen werden - %w",
+	"could not find ability - %w":                                       "Fähigkeit nicht gefunden - %w",
+	"could not find encounters of %s - %w":                              "Begegnungen von %s nicht gefunden - %w",
+	"could not find form %s - %w":                                       "Form %s nicht gefunden - %w",
+	"could not find move - %w":                                          "Attacke nicht gefunden - %w",
+	"could not find pokemon - %w":                                       "Pokemon nicht gefunden - %w",
+	"could not list areas - %w":                                         "Gebiete konnten nicht aufgelistet werden - %w",
+	"could not list locations - %w":                                     "Orte konnten nicht aufgelistet werden - %w",
+	"could not list regions - %w":                                       "Regionen konnten nicht aufgelistet werden - %w",
+	"could not look up %s - %w":                                         "%s konnte nicht nachgeschlagen werden - %w",
+	"could not look up evolutions of %s - %w":                           "Entwicklungen von %s konnten nicht nachgeschlagen werden - %w",
+	"could not look up form %s - %w":                                    "Form %s konnte nicht nachgeschlagen werden - %w",
+	"could not look up species of %s - %w":                              "Art von %s konnte nicht nachgeschlagen werden - %w",
+	"could not look up the moves of %s - %w":                            "Attacken von %s konnten nicht nachgeschlagen werden - %w",
+	"could not look up type %s - %w":                                    "Typ %s konnte nicht nachgeschlagen werden - %w",
+	"could not travel to %s - %w":                                       "Reise nach %s nicht möglich - %w",
+	"could not walk through %s - %w":                                    "%s konnte nicht durchstreift werden - %w",
+	"forms only have sprites from the latest games":                     "Formen haben nur Sprites aus den neuesten Spielen",
+	"invalid seed %q - expected a whole number":                         "ungültiger Startwert %q - erwartet eine ganze Zahl",
+	"missing ability":                                                   "fehlende Fähigkeit",
+	"missing area - use 'areas <location>' to find one":                 "fehlendes Gebiet - finde eines mit 'areas <location>'",
+	"missing location - use 'locations <region>' to list them":          "fehlender Ort - liste sie mit 'locations <region>' auf",
+	"missing move":                                                      "fehlende Attacke",
+	"missing pokemon":                                                   "fehlendes Pokemon",
+	"missing region - use 'regions' to list them":                       "fehlende Region - liste sie mit 'regions' auf",
+	"missing search term":                                               "fehlender Suchbegriff",
+	"there is no pokemon or type called %s":                             "es gibt kein Pokemon und keinen Typ namens %s",
+	"unknown argument %q - expected restock":                            "unbekanntes Argument %q - erwartet restock",
+	"unknown generation %q - expected one of %s":                        "unbekannte Generation %q - erwartet eine von %s",
+	"usage of %s:\n%s":                                                  "Verwendung von %s:\n%s",
+	"usage: matchup <pokemon|type> or matchup <attacker> vs <defender>": "Verwendung: matchup <pokemon|type> oder matchup <attacker> vs <defender>",
+	"%s cries out!":                                                     "%s schreit!",
+	"Saved the cry of %s to %s":                                         "Schrei von %s in %s gespeichert",
+	"%s has no cry":                                                     "%s hat keinen Schrei",
+	"%s has no legacy cry":                                              "%s hat keinen Schrei aus den ersten Spielen",
+	"could not download cry - %w":                                       "Schrei konnte nicht heruntergeladen werden - %w",
+	"could not save cry - %w":                                           "Schrei konnte nicht gespeichert werden - %w",
+	"invalid cry_player - %w":                                           "ungültiger cry_player - %w",
+	"no player for cries - set cry_player, e.g. 'config set cry_player ffplay -nodisp -autoexit', or use --save <file>": "kein Player für Schreie - setze cry_player, z. B. 'config set cry_player ffplay -nodisp -autoexit', oder nutze --save <file>",
+}
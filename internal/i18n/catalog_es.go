@@ -0,0 +1,252 @@
+package i18n
+
+// es translates the messages to Spanish.
+var es = map[string]string{
+	"page %d of %d":                          "página %d de %d",
+	"Exploring %s...":                        "Explorando %s...",
+	"Version: %s":                            "Versión: %s",
+	"Found Pokemon:":                         "Pokemon encontrados:",
+	"Encounter rates:":                       "Tasas de encuentro:",
+	"Pokedex is empty!":                      "¡La Pokedex está vacía!",
+	"Name: %s":                               "Nombre: %s",
+	"Genus: %s":                              "Categoría: %s",
+	"Form: %s":                               "Forma: %s",
+	"Level: %v":                              "Nivel: %v",
+	"Height: %v":                             "Altura: %v",
+	"Weight: %v":                             "Peso: %v",
+	"Stats:":                                 "Estadísticas:",
+	"Types:":                                 "Tipos:",
+	"Abilities:":                             "Habilidades:",
+	"(hidden)":                               "(oculta)",
+	"Pokedex entry (%s):":                    "Entrada de la Pokedex (%s):",
+	"Habitat: %s":                            "Hábitat: %s",
+	"Color: %s":                              "Color: %s",
+	"Shape: %s":                              "Silueta: %s",
+	"Generation: %s":                         "Generación: %s",
+	"Gender: %s":                             "Sexo: %s",
+	"genderless":                             "sin sexo",
+	"%g%% female, %g%% male":                 "%g%% hembra, %g%% macho",
+	"Legendary Pokemon":                      "Pokemon legendario",
+	"Mythical Pokemon":                       "Pokemon singular",
+	"Some names could not be translated: %s": "Algunos nombres no se pudieron traducir: %s",
+	"Could not perform command: %v":          "No se pudo ejecutar el comando: %v",
+	"Unknown command. Type 'help' for a list of commands.": "Comando desconocido. Escribe 'help' para ver la lista de comandos.",
+	"Exiting":                         "Saliendo",
+	"Already on the last page":        "Ya estás en la última página",
+	"Already on Page 1":               "Ya estás en la página 1",
+	"Capture rate of %s: %v":          "Ratio de captura de %s: %v",
+	"Chance of success: %.1f percent": "Probabilidad de éxito: %.1f por ciento",
+	"Throwing a %s at %s...":          "Lanzando una %s a %s...",
+	"Result: %s You caught %v!":       "Resultado: %s ¡Has atrapado a %v!",
+	"Success!":                        "¡Éxito!",
+	"Oh no!":                          "¡Oh, no!",
+	"Result: %s %v slipped away!":     "Resultado: %s ¡%v se ha escapado!",
+	"%s It's a shiny %s!":             "%s ¡Es un %s variocolor!",
+	"%s left: %d":                     "%s restantes: %d",
+	"Current Pokedex:":                "Pokedex actual:",
+	"You walk through %s...":          "Caminas por %s...",
+	"You search %s with %s...":        "Buscas en %s con %s...",
+	"Nothing appeared.":               "No ha aparecido nada.",
+	"A wild %s (lv %d) appeared!":     "¡Un %s salvaje (Nv. %d) apareció!",
+	"Go, %s!":                         "¡Adelante, %s!",
+	"%s leads your team.":             "%s lidera tu equipo.",
+	"You have no lead pokemon yet - catch one first.": "Aún no tienes un Pokemon líder - atrapa uno primero.",
+	"%s (lv %d) vs wild %s (lv %d)":                   "%s (Nv. %d) contra %s salvaje (Nv. %d)",
+	"%s used %s!":                                     "¡%s usó %s!",
+	"%s used %s, but it missed!":                      "¡%s usó %s, pero falló!",
+	"It doesn't affect %s...":                         "No afecta a %s...",
+	"A critical hit!":                                 "¡Un golpe crítico!",
+	"It's super effective!":                           "¡Es muy eficaz!",
+	"It's not very effective...":                      "No es muy eficaz...",
+	"%s lost %d HP.":                                  "%s pierde %d PS.",
+	"The wild %s fainted!":                            "¡El %s salvaje se ha debilitado!",
+	"You won!":                                        "¡Has ganado!",
+	"%s fainted!":                                     "¡%s se ha debilitado!",
+	"The wild %s got away.":                           "El %s salvaje ha huido.",
+	"But it failed!":                                  "¡Pero falló!",
+	"%s was burned!":                                  "¡%s se ha quemado!",
+	"%s was frozen solid!":                            "¡%s se ha congelado!",
+	"%s is paralyzed!":                                "¡%s está paralizado!",
+	"%s was poisoned!":                                "¡%s ha sido envenenado!",
+	"%s fell asleep!":                                 "¡%s se ha dormido!",
+	"%s is frozen solid!":                             "¡%s está congelado!",
+	"%s is paralyzed! It can't move!":                 "¡%s está paralizado! ¡No se puede mover!",
+	"%s is fast asleep.":                              "%s está profundamente dormido.",
+	"%s thawed out!":                                  "¡%s se ha descongelado!",
+	"%s woke up!":                                     "¡%s se ha despertado!",
+	"%s is hurt by its burn and lost %d HP.":          "%s se resiente de la quemadura y pierde %d PS.",
+	"%s is hurt by poison and lost %d HP.":            "%s sufre por el veneno y pierde %d PS.",
+	"Your pokemon are fully healed.":                  "Tus Pokemon se han recuperado por completo.",
+	"%s has fainted - use 'heal' or choose another lead with 'lead <pokemon>'": "%s está debilitado - usa 'heal' o elige otro Pokemon líder con 'lead <pokemon>'",
+	"Keep fighting with 'fight [move]' or throw a ball with 'catch'.":          "Sigue luchando con 'fight [move]' o lanza una Ball con 'catch'.",
+	"Note: %s": "Nota: %s",
+	"cache_ttl applies the next time the Pokedex starts":          "cache_ttl se aplica la próxima vez que se inicie la Pokedex",
+	"%s is set and overrides this value on the next start":        "%s está definida y sustituye este valor en el próximo inicio",
+	"limit must be a positive number":                             "limit debe ser un número positivo",
+	"unknown argument %q - expected first, last or search":        "argumento desconocido %q - se esperaba first, last o search",
+	"there are only %d pages":                                     "solo hay %d páginas",
+	"page must be at least 1":                                     "la página debe ser al menos 1",
+	"missing argument - give an area or 'goto' one first":         "falta un argumento - indica una zona o ve a una primero con 'goto'",
+	"empty location given":                                        "se indicó un lugar vacío",
+	"there is no wild pokemon here - use 'walk' to look for one":  "aquí no hay ningún Pokemon salvaje - busca uno con 'walk'",
+	"there is no wild %s here, only a wild %s":                    "aquí no hay ningún %s salvaje, solo un %s salvaje",
+	"wild pokemon can't be caught in another form":                "los Pokemon salvajes no se pueden atrapar en otra forma",
+	"catch command missing arguments":                             "faltan argumentos para el comando catch",
+	"pokemon %s already in pokedex":                               "el Pokemon %s ya está en la Pokedex",
+	"you have no %s left - see 'inventory'":                       "no te quedan %s - consulta 'inventory'",
+	"unknown ball %q - expected one of %s":                        "Ball desconocida %q - se esperaba una de %s",
+	"%s has no other forms":                                       "%s no tiene otras formas",
+	"%s has no form %s - expected one of %s":                      "%s no tiene la forma %s - se esperaba una de %s",
+	"missing parameter":                                           "falta un parámetro",
+	"you have not caught that pokemon":                            "no has atrapado ese Pokemon",
+	"lang must be one of %s":                                      "lang debe ser uno de %s",
+	"you are not in an area - use 'goto <area>' first":            "no estás en ninguna zona - usa primero 'goto <area>'",
+	"there are no wild pokemon in %s":                             "no hay Pokemon salvajes en %s",
+	"no pokemon can be found with %s here - try --method %s":      "aquí no se encuentra ningún Pokemon con %s - prueba --method %s",
+	"you have not caught %s":                                      "no has atrapado a %s",
+	"you have no pokemon to fight with - catch one first":         "no tienes ningún Pokemon para luchar - atrapa uno primero",
+	"you have no lead pokemon - choose one with 'lead <pokemon>'": "no tienes un Pokemon líder - elige uno con 'lead <pokemon>'",
+	"%s can't attack with %s - it knows %s":                       "%s no puede atacar con %s - conoce %s",
+	"missing subcommand - expected list, get or set":              "falta el subcomando - se esperaba list, get o set",
+	"missing setting name":                                        "falta el nombre del ajuste",
+	"usage: config set <key> <value>":                             "uso: config set <key> <value>",
+	"unknown subcommand %q - expected list, get or set":           "subcomando desconocido %q - se esperaba list, get o set",
+	" or ":            " o ",
+	"%v, and %d more": "%v y %d más",
+	"Unknown command %q. Run '%s help' for a list of commands.":     "Comando desconocido %q. Ejecuta '%s help' para ver la lista de comandos.",
+	"%d matches - explore an area by name or id":                    "%d coincidencias - explora una zona por nombre o id",
+	"1 match - explore an area by name or id":                       "1 coincidencia - explora una zona por nombre o id",
+	"%d more not shown - narrow the search or raise page_size":      "%d más sin mostrar - afina la búsqueda o aumenta page_size",
+	"No location areas match %q.":                                   "Ninguna zona coincide con %q.",
+	"%s Your %s evolved into %s!":                                   "%s ¡Tu %s ha evolucionado a %s!",
+	"What? %s is evolving!":                                         "¿Qué? ¡%s está evolucionando!",
+	"Congratulations!":                                              "¡Enhorabuena!",
+	"Evolutions of %s:":                                             "Evoluciones de %s:",
+	"%s can be found in:":                                           "%s se encuentra en:",
+	"%s can't be found in the wild in %s.":                          "%s no se encuentra en estado salvaje en %s.",
+	"%s can't be found in the wild.":                                "%s no se encuentra en estado salvaje.",
+	"%s can evolve into %s - choose one with 'evolve %s <pokemon>'": "%s puede evolucionar a %s - elige uno con 'evolve %s <pokemon>'",
+	"%s can't evolve yet: %s":                                       "%s aún no puede evolucionar: %s",
+	"%s does not evolve any further":                                "%s no evoluciona más",
+	"%s does not evolve into %s":                                    "%s no evoluciona a %s",
+	"%s has no evolutions":                                          "%s no tiene evoluciones",
+	"%s has no such sprite":                                         "%s no tiene ese sprite",
+	"%s has no such sprite in generation %s":                        "%s no tiene ese sprite en la generación %s",
+	"%s moves:":                                                     "Movimientos %s:",
+	"%s type":                                                       "tipo %s",
+	"%s vs %s:":                                                     "%s contra %s:",
+	"(%d version groups)":                                           "(%d grupos de versiones)",
+	"(you are here)":                                                "(estás aquí)",
+	"Accuracy: %s":                                                  "Precisión: %s",
+	"Category: %s":                                                  "Categoría: %s",
+	"Effect: %s":                                                    "Efecto: %s",
+	"PP: %d":                                                        "PP: %d",
+	"Power: %s":                                                     "Potencia: %s",
+	"Priority: %+d":                                                 "Prioridad: %+d",
+	"Type: %s":                                                      "Tipo: %s",
+	"Introduced in: %s":                                             "Introducido en: %s",
+	"Pokemon with this ability:":                                    "Pokemon con esta habilidad:",
+	"Bag:":                                                          "Mochila:",
+	"Location: %s":                                                  "Lugar: %s",
+	"Region: %s":                                                    "Región: %s",
+	"Regions:":                                                      "Regiones:",
+	"Locations in %s:":                                              "Lugares de %s:",
+	"Areas in %s:":                                                  "Zonas de %s:",
+	"No regions found.":                                             "No se encontraron regiones.",
+	"No locations found.":                                           "No se encontraron lugares.",
+	"No areas found.":                                               "No se encontraron zonas.",
+	"Travelled to %s!":                                              "¡Has viajado a %s!",
+	"You are in %s.":                                                "Estás en %s.",
+	"You haven't travelled anywhere yet. Use 'goto <area>' to set out.": "Aún no has viajado a ningún sitio. Usa 'goto <area>' para partir.",
+	"Matchups of %s:":                     "Enfrentamientos de %s:",
+	"Weak to:":                            "Débil contra:",
+	"Resists:":                            "Resiste:",
+	"Immune to:":                          "Inmune a:",
+	"no effect":                           "no afecta",
+	"normal damage":                       "daño normal",
+	"not very effective":                  "poco eficaz",
+	"super effective":                     "muy eficaz",
+	"Moves of %s in %s:":                  "Movimientos de %s en %s:",
+	"Moves of %s:":                        "Movimientos de %s:",
+	"No moves found.":                     "No se encontraron movimientos.",
+	"Random seed: %d":                     "Semilla aleatoria: %d",
+	"Usage:":                              "Uso:",
+	"Welcome to the Pokedex!":             "¡Bienvenido a la Pokedex!",
+	"lv %d":                               "Nv. %d",
+	"lv %d-%d":                            "Nv. %d-%d",
+	"level %d":                            "nivel %d",
+	"level up":                            "subir de nivel",
+	"use %s":                              "usar %s",
+	"trade":                               "intercambio",
+	"happiness %d+":                       "felicidad %d+",
+	"affection %d+":                       "afecto %d+",
+	"beauty %d+":                          "belleza %d+",
+	"holding %s":                          "con %s equipado",
+	"knowing %s":                          "conociendo %s",
+	"knowing a %s move":                   "conociendo un movimiento %s",
+	"at %s":                               "en %s",
+	"for %s":                              "por %s",
+	"with %s in the party":                "con %s en el equipo",
+	"with a %s type in the party":         "con un Pokemon de tipo %s en el equipo",
+	"female":                              "hembra",
+	"male":                                "macho",
+	"attack > defense":                    "ataque > defensa",
+	"attack < defense":                    "ataque < defensa",
+	"in the rain":                         "bajo la lluvia",
+	"upside down":                         "boca abajo",
+	"day":                                 "día",
+	"dusk":                                "atardecer",
+	"night":                               "noche",
+	"needs %s, which your bag can't hold": "necesita %s, que tu mochila no puede llevar",
+	"needs happiness %d (it has %d)":      "necesita felicidad %d (tiene %d)",
+	"needs level %d (it is level %d)":     "necesita nivel %d (está a nivel %d)",
+	"needs level %d, but only pokemon caught in game mode have a level": "necesita nivel %d, pero solo los pokemon capturados en modo juego tienen nivel",
+	"needs to %s": "necesita: %s",
+	"needs to %s, which the Pokedex can't check":                        "necesita: %s, que la Pokedex no puede comprobar",
+	"needs to be traded":                                                "necesita ser intercambiado",
+	"needs to know %s":                                                  "necesita conocer %s",
+	"needs to level up at %s":                                           "necesita subir de nivel en %s",
+	"could not add %s to pokedex - %w":                                  "no se pudo añadir %s a la pokedex - %w",
+	"could not download sprite - %w":                                    "no se pudo descargar el sprite - %w",
+	"could not encode result as json - %w":                              "no se pudo codificar el resultado como json - %w",
+	"could not explore area - %w":                                       "no se pudo explorar la zona - %w",
+	"could not fetch location area index - %w":                          "no se pudo obtener el índice de zonas - %w",
+	"could not find ability - %w":                                       "no se encontró la habilidad - %w",
+	"could not find encounters of %s - %w":                              "no se encontraron los encuentros de %s - %w",
+	"could not find form %s - %w":                                       "no se encontró la forma %s - %w",
+	"could not find move - %w":                                          "no se encontró el movimiento - %w",
+	"could not find pokemon - %w":                                       "no se encontró el pokemon - %w",
+	"could not list areas - %w":                                         "no se pudieron listar las zonas - %w",
+	"could not list locations - %w":                                     "no se pudieron listar los lugares - %w",
+	"could not list regions - %w":                                       "no se pudieron listar las regiones - %w",
+	"could not look up %s - %w":                                         "no se pudo consultar %s - %w",
+	"could not look up evolutions of %s - %w":                           "no se pudieron consultar las evoluciones de %s - %w",
+	"could not look up form %s - %w":                                    "no se pudo consultar la forma %s - %w",
+	"could not look up species of %s - %w":                              "no se pudo consultar la especie de %s - %w",
+	"could not look up the moves of %s - %w":                            "no se pudieron consultar los movimientos de %s - %w",
+	"could not look up type %s - %w":                                    "no se pudo consultar el tipo %s - %w",
+	"could not travel to %s - %w":                                       "no se pudo viajar a %s - %w",
+	"could not walk through %s - %w":                                    "no se pudo recorrer %s - %w",
+	"forms only have sprites from the latest games":                     "las formas solo tienen sprites de los últimos juegos",
+	"invalid seed %q - expected a whole number":                         "semilla no válida %q - se esperaba un número entero",
+	"missing ability":                                                   "falta la habilidad",
+	"missing area - use 'areas <location>' to find one":                 "falta la zona - busca una con 'areas <location>'",
+	"missing location - use 'locations <region>' to list them":          "falta el lugar - lístalos con 'locations <region>'",
+	"missing move":                                                      "falta el movimiento",
+	"missing pokemon":                                                   "falta el pokemon",
+	"missing region - use 'regions' to list them":                       "falta la región - lístalas con 'regions'",
+	"missing search term":                                               "falta el término de búsqueda",
+	"there is no pokemon or type called %s":                             "no hay ningún pokemon ni tipo llamado %s",
+	"unknown argument %q - expected restock":                            "argumento desconocido %q - se esperaba restock",
+	"unknown generation %q - expected one of %s":                        "generación desconocida %q - se esperaba una de %s",
+	"usage of %s:\n%s":                                                  "uso de %s:\n%s",
+	"usage: matchup <pokemon|type> or matchup <attacker> vs <defender>": "uso: matchup <pokemon|type> o matchup <attacker> vs <defender>",
+	"%s cries out!":                                                     "¡%s grita!",
+	"Saved the cry of %s to %s":                                         "Grito de %s guardado en %s",
+	"%s has no cry":                                                     "%s no tiene grito",
+	"%s has no legacy cry":                                              "%s no tiene grito de los juegos originales",
+	"could not download cry - %w":                                       "no se pudo descargar el grito - %w",
+	"could not save cry - %w":                                           "no se pudo guardar el grito - %w",
+	"invalid cry_player - %w":                                           "cry_player no válido - %w",
+	"no player for cries - set cry_player, e.g. 'config set cry_player ffplay -nodisp -autoexit', or use --save <file>": "no hay reproductor de gritos - ajusta cry_player, p. ej. 'config set cry_player ffplay -nodisp -autoexit', o usa --save <file>",
+}
@@ -0,0 +1,252 @@
+package i18n
+
+// fr translates the messages to French.
+var fr = map[string]string{
+	"page %d of %d":                          "page %d sur %d",
+	"Exploring %s...":                        "Exploration de %s...",
+	"Version: %s":                            "Version : %s",
+	"Found Pokemon:":                         "Pokemon trouvés :",
+	"Encounter rates:":                       "Taux de rencontre :",
+	"Pokedex is empty!":                      "Le Pokedex est vide !",
+	"Name: %s":                               "Nom : %s",
+	"Genus: %s":                              "Catégorie : %s",
+	"Form: %s":                               "Forme : %s",
+	"Level: %v":                              "Niveau : %v",
+	"Height: %v":                             "Taille : %v",
+	"Weight: %v":                             "Poids : %v",
+	"Stats:":                                 "Statistiques :",
+	"Types:":                                 "Types :",
+	"Abilities:":                             "Talents :",
+	"(hidden)":                               "(caché)",
+	"Pokedex entry (%s):":                    "Entrée du Pokedex (%s) :",
+	"Habitat: %s":                            "Habitat : %s",
+	"Color: %s":                              "Couleur : %s",
+	"Shape: %s":                              "Silhouette : %s",
+	"Generation: %s":                         "Génération : %s",
+	"Gender: %s":                             "Sexe : %s",
+	"genderless":                             "asexué",
+	"%g%% female, %g%% male":                 "%g%% femelle, %g%% mâle",
+	"Legendary Pokemon":                      "Pokemon légendaire",
+	"Mythical Pokemon":                       "Pokemon fabuleux",
+	"Some names could not be translated: %s": "Certains noms n'ont pas pu être traduits : %s",
+	"Could not perform command: %v":          "Impossible d'exécuter la commande : %v",
+	"Unknown command. Type 'help' for a list of commands.": "Commande inconnue. Tapez 'help' pour la liste des commandes.",
+	"Exiting":                         "Fermeture",
+	"Already on the last page":        "Déjà sur la dernière page",
+	"Already on Page 1":               "Déjà sur la page 1",
+	"Capture rate of %s: %v":          "Taux de capture de %s : %v",
+	"Chance of success: %.1f percent": "Chance de réussite : %.1f pour cent",
+	"Throwing a %s at %s...":          "Lancer d'une %s sur %s...",
+	"Result: %s You caught %v!":       "Résultat : %s Vous avez attrapé %v !",
+	"Success!":                        "Réussi !",
+	"Oh no!":                          "Oh non !",
+	"Result: %s %v slipped away!":     "Résultat : %s %v s'est échappé !",
+	"%s It's a shiny %s!":             "%s C'est un %s chromatique !",
+	"%s left: %d":                     "%s restantes : %d",
+	"Current Pokedex:":                "Pokedex actuel :",
+	"You walk through %s...":          "Vous traversez %s...",
+	"You search %s with %s...":        "Vous fouillez %s avec %s...",
+	"Nothing appeared.":               "Rien n'est apparu.",
+	"A wild %s (lv %d) appeared!":     "Un %s sauvage (N. %d) apparaît !",
+	"Go, %s!":                         "Vas-y, %s !",
+	"%s leads your team.":             "%s mène votre équipe.",
+	"You have no lead pokemon yet - catch one first.": "Vous n'avez pas encore de Pokemon de tête - attrapez-en un d'abord.",
+	"%s (lv %d) vs wild %s (lv %d)":                   "%s (N. %d) contre %s sauvage (N. %d)",
+	"%s used %s!":                                     "%s utilise %s !",
+	"%s used %s, but it missed!":                      "%s utilise %s, mais rate son attaque !",
+	"It doesn't affect %s...":                         "Ça n'affecte pas %s...",
+	"A critical hit!":                                 "Coup critique !",
+	"It's super effective!":                           "C'est super efficace !",
+	"It's not very effective...":                      "Ce n'est pas très efficace...",
+	"%s lost %d HP.":                                  "%s perd %d PV.",
+	"The wild %s fainted!":                            "Le %s sauvage est K.O. !",
+	"You won!":                                        "Vous avez gagné !",
+	"%s fainted!":                                     "%s est K.O. !",
+	"The wild %s got away.":                           "Le %s sauvage s'est enfui.",
+	"But it failed!":                                  "Mais cela échoue !",
+	"%s was burned!":                                  "%s est brûlé !",
+	"%s was frozen solid!":                            "%s est gelé !",
+	"%s is paralyzed!":                                "%s est paralysé !",
+	"%s was poisoned!":                                "%s est empoisonné !",
+	"%s fell asleep!":                                 "%s s'est endormi !",
+	"%s is frozen solid!":                             "%s est gelé !",
+	"%s is paralyzed! It can't move!":                 "%s est paralysé ! Il ne peut pas attaquer !",
+	"%s is fast asleep.":                              "%s dort profondément.",
+	"%s thawed out!":                                  "%s est dégelé !",
+	"%s woke up!":                                     "%s se réveille !",
+	"%s is hurt by its burn and lost %d HP.":          "%s souffre de sa brûlure et perd %d PV.",
+	"%s is hurt by poison and lost %d HP.":            "%s souffre du poison et perd %d PV.",
+	"Your pokemon are fully healed.":                  "Vos Pokemon sont en pleine forme.",
+	"%s has fainted - use 'heal' or choose another lead with 'lead <pokemon>'": "%s est K.O. - utilisez 'heal' ou choisissez un autre Pokemon de tête avec 'lead <pokemon>'",
+	"Keep fighting with 'fight [move]' or throw a ball with 'catch'.":          "Continuez avec 'fight [move]' ou lancez une Ball avec 'catch'.",
+	"Note: %s": "Remarque : %s",
+	"cache_ttl applies the next time the Pokedex starts":          "cache_ttl s'applique au prochain démarrage du Pokedex",
+	"%s is set and overrides this value on the next start":        "%s est défini et remplacera cette valeur au prochain démarrage",
+	"limit must be a positive number":                             "limit doit être un nombre positif",
+	"unknown argument %q - expected first, last or search":        "argument inconnu %q - first, last ou search attendu",
+	"there are only %d pages":                                     "il n'y a que %d pages",
+	"page must be at least 1":                                     "la page doit être au moins 1",
+	"missing argument - give an area or 'goto' one first":         "argument manquant - indiquez une zone ou allez-y d'abord avec 'goto'",
+	"empty location given":                                        "lieu vide indiqué",
+	"there is no wild pokemon here - use 'walk' to look for one":  "il n'y a pas de Pokemon sauvage ici - cherchez-en un avec 'walk'",
+	"there is no wild %s here, only a wild %s":                    "il n'y a pas de %s sauvage ici, seulement un %s sauvage",
+	"wild pokemon can't be caught in another form":                "les Pokemon sauvages ne peuvent pas être attrapés sous une autre forme",
+	"catch command missing arguments":                             "arguments manquants pour la commande catch",
+	"pokemon %s already in pokedex":                               "le Pokemon %s est déjà dans le Pokedex",
+	"you have no %s left - see 'inventory'":                       "il ne vous reste plus de %s - voir 'inventory'",
+	"unknown ball %q - expected one of %s":                        "Ball inconnue %q - attendu : %s",
+	"%s has no other forms":                                       "%s n'a pas d'autres formes",
+	"%s has no form %s - expected one of %s":                      "%s n'a pas de forme %s - attendu : %s",
+	"missing parameter":                                           "paramètre manquant",
+	"you have not caught that pokemon":                            "vous n'avez pas attrapé ce Pokemon",
+	"lang must be one of %s":                                      "lang doit être l'une de %s",
+	"you are not in an area - use 'goto <area>' first":            "vous n'êtes dans aucune zone - utilisez d'abord 'goto <area>'",
+	"there are no wild pokemon in %s":                             "il n'y a pas de Pokemon sauvages dans %s",
+	"no pokemon can be found with %s here - try --method %s":      "aucun Pokemon ne peut être trouvé avec %s ici - essayez --method %s",
+	"you have not caught %s":                                      "vous n'avez pas attrapé %s",
+	"you have no pokemon to fight with - catch one first":         "vous n'avez aucun Pokemon pour combattre - attrapez-en un d'abord",
+	"you have no lead pokemon - choose one with 'lead <pokemon>'": "vous n'avez pas de Pokemon de tête - choisissez-en un avec 'lead <pokemon>'",
+	"%s can't attack with %s - it knows %s":                       "%s ne peut pas attaquer avec %s - il connaît %s",
+	"missing subcommand - expected list, get or set":              "sous-commande manquante - list, get ou set attendu",
+	"missing setting name":                                        "nom du paramètre manquant",
+	"usage: config set <key> <value>":                             "utilisation : config set <key> <value>",
+	"unknown subcommand %q - expected list, get or set":           "sous-commande inconnue %q - list, get ou set attendu",
+	" or ":            " ou ",
+	"%v, and %d more": "%v, et %d de plus",
+	"Unknown command %q. Run '%s help' for a list of commands.":     "Commande inconnue %q. Lancez '%s help' pour la liste des commandes.",
+	"%d matches - explore an area by name or id":                    "%d résultats - explorez une zone par son nom ou son id",
+	"1 match - explore an area by name or id":                       "1 résultat - explorez une zone par son nom ou son id",
+	"%d more not shown - narrow the search or raise page_size":      "%d autres non affichés - affinez la recherche ou augmentez page_size",
+	"No location areas match %q.":                                   "Aucune zone ne correspond à %q.",
+	"%s Your %s evolved into %s!":                                   "%s Votre %s a évolué en %s !",
+	"What? %s is evolving!":                                         "Quoi ? %s évolue !",
+	"Congratulations!":                                              "Félicitations !",
+	"Evolutions of %s:":                                             "Évolutions de %s :",
+	"%s can be found in:":                                           "%s se trouve dans :",
+	"%s can't be found in the wild in %s.":                          "%s ne se trouve pas à l'état sauvage dans %s.",
+	"%s can't be found in the wild.":                                "%s ne se trouve pas à l'état sauvage.",
+	"%s can evolve into %s - choose one with 'evolve %s <pokemon>'": "%s peut évoluer en %s - choisissez avec 'evolve %s <pokemon>'",
+	"%s can't evolve yet: %s":                                       "%s ne peut pas encore évoluer : %s",
+	"%s does not evolve any further":                                "%s n'évolue plus",
+	"%s does not evolve into %s":                                    "%s n'évolue pas en %s",
+	"%s has no evolutions":                                          "%s n'a pas d'évolution",
+	"%s has no such sprite":                                         "%s n'a pas ce sprite",
+	"%s has no such sprite in generation %s":                        "%s n'a pas ce sprite dans la génération %s",
+	"%s moves:":                                                     "Capacités %s :",
+	"%s type":                                                       "type %s",
+	"%s vs %s:":                                                     "%s contre %s :",
+	"(%d version groups)":                                           "(%d groupes de versions)",
+	"(you are here)":                                                "(vous êtes ici)",
+	"Accuracy: %s":                                                  "Précision : %s",
+	"Category: %s":                                                  "Catégorie : %s",
+	"Effect: %s":                                                    "Effet : %s",
+	"PP: %d":                                                        "PP : %d",
+	"Power: %s":                                                     "Puissance : %s",
+	"Priority: %+d":                                                 "Priorité : %+d",
+	"Type: %s":                                                      "Type : %s",
+	"Introduced in: %s":                                             "Introduit dans : %s",
+	"Pokemon with this ability:":                                    "Pokemon ayant ce talent :",
+	"Bag:":                                                          "Sac :",
+	"Location: %s":                                                  "Lieu : %s",
+	"Region: %s":                                                    "Région : %s",
+	"Regions:":                                                      "Régions :",
+	"Locations in %s:":                                              "Lieux de %s :",
+	"Areas in %s:":                                                  "Zones de %s :",
+	"No regions found.":                                             "Aucune région trouvée.",
+	"No locations found.":                                           "Aucun lieu trouvé.",
+	"No areas found.":                                               "Aucune zone trouvée.",
+	"Travelled to %s!":                                              "Vous êtes arrivé à %s !",
+	"You are in %s.":                                                "Vous êtes à %s.",
+	"You haven't travelled anywhere yet. Use 'goto <area>' to set out.": "Vous n'avez encore voyagé nulle part. Partez avec 'goto <area>'.",
+	"Matchups of %s:":                     "Affinités de %s :",
+	"Weak to:":                            "Faible contre :",
+	"Resists:":                            "Résiste à :",
+	"Immune to:":                          "Immunisé contre :",
+	"no effect":                           "aucun effet",
+	"normal damage":                       "dégâts normaux",
+	"not very effective":                  "pas très efficace",
+	"super effective":                     "super efficace",
+	"Moves of %s in %s:":                  "Capacités de %s dans %s :",
+	"Moves of %s:":                        "Capacités de %s :",
+	"No moves found.":                     "Aucune capacité trouvée.",
+	"Random seed: %d":                     "Graine aléatoire : %d",
+	"Usage:":                              "Utilisation :",
+	"Welcome to the Pokedex!":             "Bienvenue dans le Pokedex !",
+	"lv %d":                               "N. %d",
+	"lv %d-%d":                            "N. %d-%d",
+	"level %d":                            "niveau %d",
+	"level up":                            "montée de niveau",
+	"use %s":                              "utiliser %s",
+	"trade":                               "échange",
+	"happiness %d+":                       "bonheur %d+",
+	"affection %d+":                       "affection %d+",
+	"beauty %d+":                          "beauté %d+",
+	"holding %s":                          "tenant %s",
+	"knowing %s":                          "connaissant %s",
+	"knowing a %s move":                   "connaissant une capacité %s",
+	"at %s":                               "à %s",
+	"for %s":                              "contre %s",
+	"with %s in the party":                "avec %s dans l'équipe",
+	"with a %s type in the party":         "avec un Pokemon %s dans l'équipe",
+	"female":                              "femelle",
+	"male":                                "mâle",
+	"attack > defense":                    "attaque > défense",
+	"attack < defense":                    "attaque < défense",
+	"in the rain":                         "sous la pluie",
+	"upside down":                         "à l'envers",
+	"day":                                 "jour",
+	"dusk":                                "crépuscule",
+	"night":                               "nuit",
+	"needs %s, which your bag can't hold": "nécessite %s, que votre sac ne peut pas contenir",
+	"needs happiness %d (it has %d)":      "nécessite un bonheur de %d (il a %d)",
+	"needs level %d (it is level %d)":     "nécessite le niveau %d (il est niveau %d)",
+	"needs level %d, but only pokemon caught in game mode have a level": "nécessite le niveau %d, mais seuls les pokemon attrapés en mode jeu ont un niveau",
+	"needs to %s": "doit : %s",
+	"needs to %s, which the Pokedex can't check":                        "doit : %s, ce que le Pokedex ne peut pas vérifier",
+	"needs to be traded":                                                "doit être échangé",
+	"needs to know %s":                                                  "doit connaître %s",
+	"needs to level up at %s":                                           "doit monter de niveau à %s",
+	"could not add %s to pokedex - %w":                                  "impossible d'ajouter %s au pokedex - %w",
+	"could not download sprite - %w":                                    "impossible de télécharger le sprite - %w",
+	"could not encode result as json - %w":                              "impossible d'encoder le résultat en json - %w",
+	"could not explore area - %w":                                       "impossible d'explorer la zone - %w",
+	"could not fetch location area index - %w":                          "impossible de récupérer l'index des zones - %w",
+	"could not find ability - %w":                                       "talent introuvable - %w",
+	"could not find encounters of %s - %w":                              "rencontres de %s introuvables - %w",
+	"could not find form %s - %w":                                       "forme %s introuvable - %w",
+	"could not find move - %w":                                          "capacité introuvable - %w",
+	"could not find pokemon - %w":                                       "pokemon introuvable - %w",
+	"could not list areas - %w":                                         "impossible de lister les zones - %w",
+	"could not list locations - %w":                                     "impossible de lister les lieux - %w",
+	"could not list regions - %w":                                       "impossible de lister les régions - %w",
+	"could not look up %s - %w":                                         "impossible de consulter %s - %w",
+	"could not look up evolutions of %s - %w":                           "impossible de consulter les évolutions de %s - %w",
+	"could not look up form %s - %w":                                    "impossible de consulter la forme %s - %w",
+	"could not look up species of %s - %w":                              "impossible de consulter l'espèce de %s - %w",
+	"could not look up the moves of %s - %w":                            "impossible de consulter les capacités de %s - %w",
+	"could not look up type %s - %w":                                    "impossible de consulter le type %s - %w",
+	"could not travel to %s - %w":                                       "impossible de voyager vers %s - %w",
+	"could not walk through %s - %w":                                    "impossible de parcourir %s - %w",
+	"forms only have sprites from the latest games":                     "les formes n'ont que des sprites des derniers jeux",
+	"invalid seed %q - expected a whole number":                         "graine invalide %q - un nombre entier est attendu",
+	"missing ability":                                                   "talent manquant",
+	"missing area - use 'areas <location>' to find one":                 "zone manquante - trouvez-en une avec 'areas <location>'",
+	"missing location - use 'locations <region>' to list them":          "lieu manquant - listez-les avec 'locations <region>'",
+	"missing move":                                                      "capacité manquante",
+	"missing pokemon":                                                   "pokemon manquant",
+	"missing region - use 'regions' to list them":                       "région manquante - listez-les avec 'regions'",
+	"missing search term":                                               "terme de recherche manquant",
+	"there is no pokemon or type called %s":                             "il n'y a pas de pokemon ni de type nommé %s",
+	"unknown argument %q - expected restock":                            "argument inconnu %q - restock attendu",
+	"unknown generation %q - expected one of %s":                        "génération inconnue %q - une de %s attendue",
+	"usage of %s:\n%s":                                                  "utilisation de %s :\n%s",
+	"usage: matchup <pokemon|type> or matchup <attacker> vs <defender>": "utilisation : matchup <pokemon|type> ou matchup <attacker> vs <defender>",
+	"%s cries out!":                                                     "%s pousse son cri !",
+	"Saved the cry of %s to %s":                                         "Cri de %s enregistré dans %s",
+	"%s has no cry":                                                     "%s n'a pas de cri",
+	"%s has no legacy cry":                                              "%s n'a pas de cri des premiers jeux",
+	"could not download cry - %w":                                       "impossible de télécharger le cri - %w",
+	"could not save cry - %w":                                           "impossible d'enregistrer le cri - %w",
+	"invalid cry_player - %w":                                           "cry_player invalide - %w",
+	"no player for cries - set cry_player, e.g. 'config set cry_player ffplay -nodisp -autoexit', or use --save <file>": "aucun lecteur pour les cris - définissez cry_player, p. ex. 'config set cry_player ffplay -nodisp -autoexit', ou utilisez --save <file>",
+}
@@ -0,0 +1,252 @@
+package i18n
+
+// it translates the messages to Italian.
+var it = map[string]string{
+	"page %d of %d":                          "pagina %d di %d",
+	"Exploring %s...":                        "Esplorazione di %s...",
+	"Version: %s":                            "Versione: %s",
+	"Found Pokemon:":                         "Pokemon trovati:",
+	"Encounter rates:":                       "Tassi di incontro:",
+	"Pokedex is empty!":                      "Il Pokedex è vuoto!",
+	"Name: %s":                               "Nome: %s",
+	"Genus: %s":                              "Categoria: %s",
+	"Form: %s":                               "Forma: %s",
+	"Level: %v":                              "Livello: %v",
+	"Height: %v":                             "Altezza: %v",
+	"Weight: %v":                             "Peso: %v",
+	"Stats:":                                 "Statistiche:",
+	"Types:":                                 "Tipi:",
+	"Abilities:":                             "Abilità:",
+	"(hidden)":                               "(nascosta)",
+	"Pokedex entry (%s):":                    "Voce del Pokedex (%s):",
+	"Habitat: %s":                            "Habitat: %s",
+	"Color: %s":                              "Colore: %s",
+	"Shape: %s":                              "Sagoma: %s",
+	"Generation: %s":                         "Generazione: %s",
+	"Gender: %s":                             "Sesso: %s",
+	"genderless":                             "asessuato",
+	"%g%% female, %g%% male":                 "%g%% femmina, %g%% maschio",
+	"Legendary Pokemon":                      "Pokemon leggendario",
+	"Mythical Pokemon":                       "Pokemon misterioso",
+	"Some names could not be translated: %s": "Alcuni nomi non sono stati tradotti: %s",
+	"Could not perform command: %v":          "Impossibile eseguire il comando: %v",
+	"Unknown command. Type 'help' for a list of commands.": "Comando sconosciuto. Digita 'help' per l'elenco dei comandi.",
+	"Exiting":                         "Uscita",
+	"Already on the last page":        "Già all'ultima pagina",
+	"Already on Page 1":               "Già alla pagina 1",
+	"Capture rate of %s: %v":          "Tasso di cattura di %s: %v",
+	"Chance of success: %.1f percent": "Probabilità di successo: %.1f per cento",
+	"Throwing a %s at %s...":          "Lancio di una %s contro %s...",
+	"Result: %s You caught %v!":       "Risultato: %s Hai catturato %v!",
+	"Success!":                        "Successo!",
+	"Oh no!":                          "Oh no!",
+	"Result: %s %v slipped away!":     "Risultato: %s %v è scappato!",
+	"%s It's a shiny %s!":             "%s È un %s cromatico!",
+	"%s left: %d":                     "%s rimaste: %d",
+	"Current Pokedex:":                "Pokedex attuale:",
+	"You walk through %s...":          "Attraversi %s...",
+	"You search %s with %s...":        "Cerchi in %s con %s...",
+	"Nothing appeared.":               "Non è apparso nulla.",
+	"A wild %s (lv %d) appeared!":     "È apparso un %s selvatico (Lv. %d)!",
+	"Go, %s!":                         "Vai, %s!",
+	"%s leads your team.":             "%s guida la tua squadra.",
+	"You have no lead pokemon yet - catch one first.": "Non hai ancora un Pokemon in testa - catturane uno prima.",
+	"%s (lv %d) vs wild %s (lv %d)":                   "%s (Lv. %d) contro %s selvatico (Lv. %d)",
+	"%s used %s!":                                     "%s usa %s!",
+	"%s used %s, but it missed!":                      "%s usa %s, ma manca il bersaglio!",
+	"It doesn't affect %s...":                         "Non ha effetto su %s...",
+	"A critical hit!":                                 "Brutto colpo!",
+	"It's super effective!":                           "È superefficace!",
+	"It's not very effective...":                      "Non è molto efficace...",
+	"%s lost %d HP.":                                  "%s perde %d PS.",
+	"The wild %s fainted!":                            "Il %s selvatico è esausto!",
+	"You won!":                                        "Hai vinto!",
+	"%s fainted!":                                     "%s è esausto!",
+	"The wild %s got away.":                           "Il %s selvatico è fuggito.",
+	"But it failed!":                                  "Ma non ha effetto!",
+	"%s was burned!":                                  "%s è stato scottato!",
+	"%s was frozen solid!":                            "%s è stato congelato!",
+	"%s is paralyzed!":                                "%s è paralizzato!",
+	"%s was poisoned!":                                "%s è stato avvelenato!",
+	"%s fell asleep!":                                 "%s si è addormentato!",
+	"%s is frozen solid!":                             "%s è congelato!",
+	"%s is paralyzed! It can't move!":                 "%s è paralizzato! Non può muoversi!",
+	"%s is fast asleep.":                              "%s dorme profondamente.",
+	"%s thawed out!":                                  "%s si è scongelato!",
+	"%s woke up!":                                     "%s si è svegliato!",
+	"%s is hurt by its burn and lost %d HP.":          "%s è ferito dalla scottatura e perde %d PS.",
+	"%s is hurt by poison and lost %d HP.":            "%s è ferito dal veleno e perde %d PS.",
+	"Your pokemon are fully healed.":                  "I tuoi Pokemon sono in perfetta forma.",
+	"%s has fainted - use 'heal' or choose another lead with 'lead <pokemon>'": "%s è esausto - usa 'heal' o scegli un altro Pokemon in testa con 'lead <pokemon>'",
+	"Keep fighting with 'fight [move]' or throw a ball with 'catch'.":          "Continua a lottare con 'fight [move]' o lancia una Ball con 'catch'.",
+	"Note: %s": "Nota: %s",
+	"cache_ttl applies the next time the Pokedex starts":          "cache_ttl si applica al prossimo avvio del Pokedex",
+	"%s is set and overrides this value on the next start":        "%s è impostata e sostituisce questo valore al prossimo avvio",
+	"limit must be a positive number":                             "limit deve essere un numero positivo",
+	"unknown argument %q - expected first, last or search":        "argomento sconosciuto %q - previsto first, last o search",
+	"there are only %d pages":                                     "ci sono solo %d pagine",
+	"page must be at least 1":                                     "la pagina deve essere almeno 1",
+	"missing argument - give an area or 'goto' one first":         "argomento mancante - indica una zona o raggiungine una prima con 'goto'",
+	"empty location given":                                        "luogo vuoto indicato",
+	"there is no wild pokemon here - use 'walk' to look for one":  "qui non c'è nessun Pokemon selvatico - cercane uno con 'walk'",
+	"there is no wild %s here, only a wild %s":                    "qui non c'è nessun %s selvatico, solo un %s selvatico",
+	"wild pokemon can't be caught in another form":                "i Pokemon selvatici non possono essere catturati in un'altra forma",
+	"catch command missing arguments":                             "argomenti mancanti per il comando catch",
+	"pokemon %s already in pokedex":                               "il Pokemon %s è già nel Pokedex",
+	"you have no %s left - see 'inventory'":                       "non hai più %s - vedi 'inventory'",
+	"unknown ball %q - expected one of %s":                        "Ball sconosciuta %q - prevista una tra %s",
+	"%s has no other forms":                                       "%s non ha altre forme",
+	"%s has no form %s - expected one of %s":                      "%s non ha la forma %s - prevista una tra %s",
+	"missing parameter":                                           "parametro mancante",
+	"you have not caught that pokemon":                            "non hai catturato quel Pokemon",
+	"lang must be one of %s":                                      "lang deve essere uno tra %s",
+	"you are not in an area - use 'goto <area>' first":            "non sei in nessuna zona - usa prima 'goto <area>'",
+	"there are no wild pokemon in %s":                             "non ci sono Pokemon selvatici in %s",
+	"no pokemon can be found with %s here - try --method %s":      "qui non si trova nessun Pokemon con %s - prova --method %s",
+	"you have not caught %s":                                      "non hai catturato %s",
+	"you have no pokemon to fight with - catch one first":         "non hai nessun Pokemon per lottare - catturane uno prima",
+	"you have no lead pokemon - choose one with 'lead <pokemon>'": "non hai un Pokemon in testa - scegline uno con 'lead <pokemon>'",
+	"%s can't attack with %s - it knows %s":                       "%s non può attaccare con %s - conosce %s",
+	"missing subcommand - expected list, get or set":              "sottocomando mancante - previsto list, get o set",
+	"missing setting name":                                        "nome dell'impostazione mancante",
+	"usage: config set <key> <value>":                             "uso: config set <key> <value>",
+	"unknown subcommand %q - expected list, get or set":           "sottocomando sconosciuto %q - previsto list, get o set",
+	" or ":            " o ",
+	"%v, and %d more": "%v e altri %d",
+	"Unknown command %q. Run '%s help' for a list of commands.":     "Comando sconosciuto %q. Esegui '%s help' per l'elenco dei comandi.",
+	"%d matches - explore an area by name or id":                    "%d risultati - esplora un'area per nome o id",
+	"1 match - explore an area by name or id":                       "1 risultato - esplora un'area per nome o id",
+	"%d more not shown - narrow the search or raise page_size":      "altri %d non mostrati - restringi la ricerca o aumenta page_size",
+	"No location areas match %q.":                                   "Nessuna area corrisponde a %q.",
+	"%s Your %s evolved into %s!":                                   "%s Il tuo %s si è evoluto in %s!",
+	"What? %s is evolving!":                                         "Cosa? %s si sta evolvendo!",
+	"Congratulations!":                                              "Congratulazioni!",
+	"Evolutions of %s:":                                             "Evoluzioni di %s:",
+	"%s can be found in:":                                           "%s si trova in:",
+	"%s can't be found in the wild in %s.":                          "%s non si trova allo stato selvatico in %s.",
+	"%s can't be found in the wild.":                                "%s non si trova allo stato selvatico.",
+	"%s can evolve into %s - choose one with 'evolve %s <pokemon>'": "%s può evolversi in %s - scegline uno con 'evolve %s <pokemon>'",
+	"%s can't evolve yet: %s":                                       "%s non può ancora evolversi: %s",
+	"%s does not evolve any further":                                "%s non si evolve ulteriormente",
+	"%s does not evolve into %s":                                    "%s non si evolve in %s",
+	"%s has no evolutions":                                          "%s non ha evoluzioni",
+	"%s has no such sprite":                                         "%s non ha questo sprite",
+	"%s has no such sprite in generation %s":                        "%s non ha questo sprite nella generazione %s",
+	"%s moves:":                                                     "Mosse %s:",
+	"%s type":                                                       "tipo %s",
+	"%s vs %s:":                                                     "%s contro %s:",
+	"(%d version groups)":                                           "(%d gruppi di versioni)",
+	"(you are here)":                                                "(sei qui)",
+	"Accuracy: %s":                                                  "Precisione: %s",
+	"Category: %s":                                                  "Categoria: %s",
+	"Effect: %s":                                                    "Effetto: %s",
+	"PP: %d":                                                        "PP: %d",
+	"Power: %s":                                                     "Potenza: %s",
+	"Priority: %+d":                                                 "Priorità: %+d",
+	"Type: %s":                                                      "Tipo: %s",
+	"Introduced in: %s":                                             "Introdotto in: %s",
+	"Pokemon with this ability:":                                    "Pokemon con questa abilità:",
+	"Bag:":                                                          "Borsa:",
+	"Location: %s":                                                  "Luogo: %s",
+	"Region: %s":                                                    "Regione: %s",
+	"Regions:":                                                      "Regioni:",
+	"Locations in %s:":                                              "Luoghi di %s:",
+	"Areas in %s:":                                                  "Aree di %s:",
+	"No regions found.":                                             "Nessuna regione trovata.",
+	"No locations found.":                                           "Nessun luogo trovato.",
+	"No areas found.":                                               "Nessuna area trovata.",
+	"Travelled to %s!":                                              "Sei arrivato a %s!",
+	"You are in %s.":                                                "Sei in %s.",
+	"You haven't travelled anywhere yet. Use 'goto <area>' to set out.": "Non hai ancora viaggiato da nessuna parte. Parti con 'goto <area>'.",
+	"Matchups of %s:":                     "Confronti di %s:",
+	"Weak to:":                            "Debole contro:",
+	"Resists:":                            "Resiste a:",
+	"Immune to:":                          "Immune a:",
+	"no effect":                           "nessun effetto",
+	"normal damage":                       "danno normale",
+	"not very effective":                  "non molto efficace",
+	"super effective":                     "superefficace",
+	"Moves of %s in %s:":                  "Mosse di %s in %s:",
+	"Moves of %s:":                        "Mosse di %s:",
+	"No moves found.":                     "Nessuna mossa trovata.",
+	"Random seed: %d":                     "Seme casuale: %d",
+	"Usage:":                              "Uso:",
+	"Welcome to the Pokedex!":             "Benvenuto nel Pokedex!",
+	"lv %d":                               "Lv. %d",
+	"lv %d-%d":                            "Lv. %d-%d",
+	"level %d":                            "livello %d",
+	"level up":                            "salire di livello",
+	"use %s":                              "usare %s",
+	"trade":                               "scambio",
+	"happiness %d+":                       "affetto %d+",
+	"affection %d+":                       "affezione %d+",
+	"beauty %d+":                          "bellezza %d+",
+	"holding %s":                          "tenendo %s",
+	"knowing %s":                          "conoscendo %s",
+	"knowing a %s move":                   "conoscendo una mossa %s",
+	"at %s":                               "a %s",
+	"for %s":                              "con %s",
+	"with %s in the party":                "con %s in squadra",
+	"with a %s type in the party":         "con un Pokemon di tipo %s in squadra",
+	"female":                              "femmina",
+	"male":                                "maschio",
+	"attack > defense":                    "attacco > difesa",
+	"attack < defense":                    "attacco < difesa",
+	"in the rain":                         "sotto la pioggia",
+	"upside down":                         "capovolto",
+	"day":                                 "giorno",
+	"dusk":                                "crepuscolo",
+	"night":                               "notte",
+	"needs %s, which your bag can't hold": "richiede %s, che la tua borsa non può contenere",
+	"needs happiness %d (it has %d)":      "richiede affetto %d (ne ha %d)",
+	"needs level %d (it is level %d)":     "richiede il livello %d (è al livello %d)",
+	"needs level %d, but only pokemon caught in game mode have a level": "richiede il livello %d, ma solo i pokemon catturati in modalità gioco hanno un livello",
+	"needs to %s": "richiede: %s",
+	"needs to %s, which the Pokedex can't check":                        "richiede: %s, che il Pokedex non può verificare",
+	"needs to be traded":                                                "deve essere scambiato",
+	"needs to know %s":                                                  "deve conoscere %s",
+	"needs to level up at %s":                                           "deve salire di livello a %s",
+	"could not add %s to pokedex - %w":                                  "impossibile aggiungere %s al pokedex - %w",
+	"could not download sprite - %w":                                    "impossibile scaricare lo sprite - %w",
+	"could not encode result as json - %w":                              "impossibile codificare il risultato in json - %w",
+	"could not explore area - %w":                                       "impossibile esplorare l'area - %w",
+	"could not fetch location area index - %w":                          "impossibile recuperare l'indice delle aree - %w",
+	"could not find ability - %w":                                       "abilità non trovata - %w",
+	"could not find encounters of %s - %w":                              "incontri di %s non trovati - %w",
+	"could not find form %s - %w":                                       "forma %s non trovata - %w",
+	"could not find move - %w":                                          "mossa non trovata - %w",
+	"could not find pokemon - %w":                                       "pokemon non trovato - %w",
+	"could not list areas - %w":                                         "impossibile elencare le aree - %w",
+	"could not list locations - %w":                                     "impossibile elencare i luoghi - %w",
+	"could not list regions - %w":                                       "impossibile elencare le regioni - %w",
+	"could not look up %s - %w":                                         "impossibile consultare %s - %w",
+	"could not look up evolutions of %s - %w":                           "impossibile consultare le evoluzioni di %s - %w",
+	"could not look up form %s - %w":                                    "impossibile consultare la forma %s - %w",
+	"could not look up species of %s - %w":                              "impossibile consultare la specie di %s - %w",
+	"could not look up the moves of %s - %w":                            "impossibile consultare le mosse di %s - %w",
+	"could not look up type %s - %w":                                    "impossibile consultare il tipo %s - %w",
+	"could not travel to %s - %w":                                       "impossibile viaggiare verso %s - %w",
+	"could not walk through %s - %w":                                    "impossibile attraversare %s - %w",
+	"forms only have sprites from the latest games":                     "le forme hanno solo sprite degli ultimi giochi",
+	"invalid seed %q - expected a whole number":                         "seme non valido %q - previsto un numero intero",
+	"missing ability":                                                   "abilità mancante",
+	"missing area - use 'areas <location>' to find one":                 "area mancante - trovane una con 'areas <location>'",
+	"missing location - use 'locations <region>' to list them":          "luogo mancante - elencali con 'locations <region>'",
+	"missing move":                                                      "mossa mancante",
+	"missing pokemon":                                                   "pokemon mancante",
+	"missing region - use 'regions' to list them":                       "regione mancante - elencale con 'regions'",
+	"missing search term":                                               "termine di ricerca mancante",
+	"there is no pokemon or type called %s":                             "non esiste un pokemon o un tipo chiamato %s",
+	"unknown argument %q - expected restock":                            "argomento sconosciuto %q - previsto restock",
+	"unknown generation %q - expected one of %s":                        "generazione sconosciuta %q - prevista una tra %s",
+	"usage of %s:\n%s":                                                  "uso di %s:\n%s",
+	"usage: matchup <pokemon|type> or matchup <attacker> vs <defender>": "uso: matchup <pokemon|type> o matchup <attacker> vs <defender>",
+	"%s cries out!":                                                     "%s emette il suo verso!",
+	"Saved the cry of %s to %s":                                         "Verso di %s salvato in %s",
+	"%s has no cry":                                                     "%s non ha un verso",
+	"%s has no legacy cry":                                              "%s non ha un verso dei giochi originali",
+	"could not download cry - %w":                                       "impossibile scaricare il verso - %w",
+	"could not save cry - %w":                                           "impossibile salvare il verso - %w",
+	"invalid cry_player - %w":                                           "cry_player non valido - %w",
+	"no player for cries - set cry_player, e.g. 'config set cry_player ffplay -nodisp -autoexit', or use --save <file>": "nessun lettore per i versi - imposta cry_player, ad es. 'config set cry_player ffplay -nodisp -autoexit', o usa --save <file>",
+}
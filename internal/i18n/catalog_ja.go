@@ -0,0 +1,252 @@
+package i18n
+
+// ja translates the messages to Japanese.
+var ja = map[string]string{
+	"page %d of %d":                          "%d / %d ページ",
+	"Exploring %s...":                        "%sを探索中...",
+	"Version: %s":                            "バージョン: %s",
+	"Found Pokemon:":                         "見つけたポケモン:",
+	"Encounter rates:":                       "遭遇率:",
+	"Pokedex is empty!":                      "図鑑は空っぽです!",
+	"Name: %s":                               "名前: %s",
+	"Genus: %s":                              "分類: %s",
+	"Form: %s":                               "姿: %s",
+	"Level: %v":                              "レベル: %v",
+	"Height: %v":                             "高さ: %v",
+	"Weight: %v":                             "重さ: %v",
+	"Stats:":                                 "能力:",
+	"Types:":                                 "タイプ:",
+	"Abilities:":                             "特性:",
+	"(hidden)":                               "(隠れ特性)",
+	"Pokedex entry (%s):":                    "図鑑説明 (%s):",
+	"Habitat: %s":                            "生息地: %s",
+	"Color: %s":                              "色: %s",
+	"Shape: %s":                              "形: %s",
+	"Generation: %s":                         "世代: %s",
+	"Gender: %s":                             "性別: %s",
+	"genderless":                             "不明",
+	"%g%% female, %g%% male":                 "メス %g%%, オス %g%%",
+	"Legendary Pokemon":                      "伝説のポケモン",
+	"Mythical Pokemon":                       "幻のポケモン",
+	"Some names could not be translated: %s": "一部の名前を翻訳できませんでした: %s",
+	"Could not perform command: %v":          "コマンドを実行できません: %v",
+	"Unknown command. Type 'help' for a list of commands.": "不明なコマンドです。'help' でコマンドの一覧を表示します。",
+	"Exiting":                         "終了します",
+	"Already on the last page":        "すでに最後のページです",
+	"Already on Page 1":               "すでに1ページ目です",
+	"Capture rate of %s: %v":          "%sの捕獲率: %v",
+	"Chance of success: %.1f percent": "成功率: %.1f パーセント",
+	"Throwing a %s at %s...":          "%sを%sに投げた...",
+	"Result: %s You caught %v!":       "結果: %s %vを捕まえた!",
+	"Success!":                        "成功!",
+	"Oh no!":                          "ああっ!",
+	"Result: %s %v slipped away!":     "結果: %s %vに逃げられた!",
+	"%s It's a shiny %s!":             "%s 色違いの%sだ!",
+	"%s left: %d":                     "%sの残り: %d",
+	"Current Pokedex:":                "現在の図鑑:",
+	"You walk through %s...":          "%sを歩いている...",
+	"You search %s with %s...":        "%sを%sで探している...",
+	"Nothing appeared.":               "何も現れなかった。",
+	"A wild %s (lv %d) appeared!":     "野生の%s (Lv. %d) が現れた!",
+	"Go, %s!":                         "行け、%s!",
+	"%s leads your team.":             "%sがチームの先頭です。",
+	"You have no lead pokemon yet - catch one first.": "先頭のポケモンがいません - まず捕まえてください。",
+	"%s (lv %d) vs wild %s (lv %d)":                   "%s (Lv. %d) 対 野生の%s (Lv. %d)",
+	"%s used %s!":                                     "%sの%s!",
+	"%s used %s, but it missed!":                      "%sの%s! しかし外れた!",
+	"It doesn't affect %s...":                         "%sには効果がないようだ...",
+	"A critical hit!":                                 "急所に当たった!",
+	"It's super effective!":                           "効果は抜群だ!",
+	"It's not very effective...":                      "効果は今ひとつのようだ...",
+	"%s lost %d HP.":                                  "%sは %d HPを失った。",
+	"The wild %s fainted!":                            "野生の%sは倒れた!",
+	"You won!":                                        "勝った!",
+	"%s fainted!":                                     "%sは倒れた!",
+	"The wild %s got away.":                           "野生の%sに逃げられた。",
+	"But it failed!":                                  "しかし うまく決まらなかった!",
+	"%s was burned!":                                  "%sは やけどを負った!",
+	"%s was frozen solid!":                            "%sは 凍りついた!",
+	"%s is paralyzed!":                                "%sは まひした!",
+	"%s was poisoned!":                                "%sは 毒を浴びた!",
+	"%s fell asleep!":                                 "%sは 眠ってしまった!",
+	"%s is frozen solid!":                             "%sは 凍ってしまって 動けない!",
+	"%s is paralyzed! It can't move!":                 "%sは 体が しびれて 動けない!",
+	"%s is fast asleep.":                              "%sは ぐうぐう 眠っている。",
+	"%s thawed out!":                                  "%sの こおりが とけた!",
+	"%s woke up!":                                     "%sは 目を 覚ました!",
+	"%s is hurt by its burn and lost %d HP.":          "%sは やけどの ダメージを 受けて %d HP 失った。",
+	"%s is hurt by poison and lost %d HP.":            "%sは 毒の ダメージを 受けて %d HP 失った。",
+	"Your pokemon are fully healed.":                  "ポケモンは 元気になりました。",
+	"%s has fainted - use 'heal' or choose another lead with 'lead <pokemon>'": "%sは 倒れています - 'heal' を使うか、'lead <pokemon>' で別の先頭を選んでください",
+	"Keep fighting with 'fight [move]' or throw a ball with 'catch'.":          "'fight [move]' で戦い続けるか、'catch' でボールを投げよう。",
+	"Note: %s": "注意: %s",
+	"cache_ttl applies the next time the Pokedex starts":          "cache_ttl は次回の起動時に反映されます",
+	"%s is set and overrides this value on the next start":        "%s が設定されているため、次回の起動時にこの値は上書きされます",
+	"limit must be a positive number":                             "limit は正の数にしてください",
+	"unknown argument %q - expected first, last or search":        "不明な引数 %q - first, last, search のいずれかを指定してください",
+	"there are only %d pages":                                     "ページは %d ページしかありません",
+	"page must be at least 1":                                     "ページは1以上にしてください",
+	"missing argument - give an area or 'goto' one first":         "引数がありません - エリアを指定するか、先に 'goto' で移動してください",
+	"empty location given":                                        "場所が空です",
+	"there is no wild pokemon here - use 'walk' to look for one":  "ここに野生のポケモンはいません - 'walk' で探してください",
+	"there is no wild %s here, only a wild %s":                    "ここに野生の%sはいません。いるのは野生の%sだけです",
+	"wild pokemon can't be caught in another form":                "野生のポケモンは別の姿では捕まえられません",
+	"catch command missing arguments":                             "catch コマンドの引数がありません",
+	"pokemon %s already in pokedex":                               "%sはすでに図鑑にいます",
+	"you have no %s left - see 'inventory'":                       "%sが残っていません - 'inventory' を確認してください",
+	"unknown ball %q - expected one of %s":                        "不明なボール %q - %s のいずれかを指定してください",
+	"%s has no other forms":                                       "%sに別の姿はありません",
+	"%s has no form %s - expected one of %s":                      "%sに%sの姿はありません - %s のいずれかを指定してください",
+	"missing parameter":                                           "パラメーターがありません",
+	"you have not caught that pokemon":                            "そのポケモンは捕まえていません",
+	"lang must be one of %s":                                      "lang は %s のいずれかにしてください",
+	"you are not in an area - use 'goto <area>' first":            "エリアにいません - 先に 'goto <area>' を使ってください",
+	"there are no wild pokemon in %s":                             "%sに野生のポケモンはいません",
+	"no pokemon can be found with %s here - try --method %s":      "ここでは%sでポケモンは見つかりません - --method %s を試してください",
+	"you have not caught %s":                                      "%sを捕まえていません",
+	"you have no pokemon to fight with - catch one first":         "戦えるポケモンがいません - まず捕まえてください",
+	"you have no lead pokemon - choose one with 'lead <pokemon>'": "先頭のポケモンがいません - 'lead <pokemon>' で選んでください",
+	"%s can't attack with %s - it knows %s":                       "%sは%sで攻撃できません - 覚えている技: %s",
+	"missing subcommand - expected list, get or set":              "サブコマンドがありません - list, get, set のいずれかを指定してください",
+	"missing setting name":                                        "設定名がありません",
+	"usage: config set <key> <value>":                             "使い方: config set <key> <value>",
+	"unknown subcommand %q - expected list, get or set":           "不明なサブコマンド %q - list, get, set のいずれかを指定してください",
+	" or ":            " または ",
+	"%v, and %d more": "%v、ほか %d 件",
+	"Unknown command %q. Run '%s help' for a list of commands.":     "不明なコマンド %q です。'%s help' でコマンドの一覧を表示します。",
+	"%d matches - explore an area by name or id":                    "%d 件 - 名前か ID でエリアを探索できます",
+	"1 match - explore an area by name or id":                       "1 件 - 名前か ID でエリアを探索できます",
+	"%d more not shown - narrow the search or raise page_size":      "ほか %d 件は非表示 - 検索を絞り込むか page_size を増やしてください",
+	"No location areas match %q.":                                   "%q に一致するエリアはありません。",
+	"%s Your %s evolved into %s!":                                   "%s %sは %sに 進化した!",
+	"What? %s is evolving!":                                         "おや!? %sの 様子が……!",
+	"Congratulations!":                                              "おめでとう!",
+	"Evolutions of %s:":                                             "%sの 進化:",
+	"%s can be found in:":                                           "%sが 見つかる場所:",
+	"%s can't be found in the wild in %s.":                          "%sは %sでは 野生で 見つかりません。",
+	"%s can't be found in the wild.":                                "%sは 野生では 見つかりません。",
+	"%s can evolve into %s - choose one with 'evolve %s <pokemon>'": "%sは %sに 進化できます - 'evolve %s <pokemon>' で選んでください",
+	"%s can't evolve yet: %s":                                       "%sは まだ 進化できません: %s",
+	"%s does not evolve any further":                                "%sは これ以上 進化しません",
+	"%s does not evolve into %s":                                    "%sは %sに 進化しません",
+	"%s has no evolutions":                                          "%sは 進化しません",
+	"%s has no such sprite":                                         "%sには その スプライトが ありません",
+	"%s has no such sprite in generation %s":                        "%sには 第%s世代の その スプライトが ありません",
+	"%s moves:":                                                     "%sタイプの わざ:",
+	"%s type":                                                       "%sタイプ",
+	"%s vs %s:":                                                     "%s 対 %s:",
+	"(%d version groups)":                                           "(%d バージョングループ)",
+	"(you are here)":                                                "(現在地)",
+	"Accuracy: %s":                                                  "命中: %s",
+	"Category: %s":                                                  "分類: %s",
+	"Effect: %s":                                                    "効果: %s",
+	"PP: %d":                                                        "PP: %d",
+	"Power: %s":                                                     "威力: %s",
+	"Priority: %+d":                                                 "優先度: %+d",
+	"Type: %s":                                                      "タイプ: %s",
+	"Introduced in: %s":                                             "初登場: %s",
+	"Pokemon with this ability:":                                    "この 特性を 持つ ポケモン:",
+	"Bag:":                                                          "バッグ:",
+	"Location: %s":                                                  "場所: %s",
+	"Region: %s":                                                    "地方: %s",
+	"Regions:":                                                      "地方:",
+	"Locations in %s:":                                              "%sの 場所:",
+	"Areas in %s:":                                                  "%sの エリア:",
+	"No regions found.":                                             "地方が 見つかりません。",
+	"No locations found.":                                           "場所が 見つかりません。",
+	"No areas found.":                                               "エリアが 見つかりません。",
+	"Travelled to %s!":                                              "%sに 到着した!",
+	"You are in %s.":                                                "現在地は %sです。",
+	"You haven't travelled anywhere yet. Use 'goto <area>' to set out.": "まだ どこにも 行っていません。'goto <area>' で 出発しましょう。",
+	"Matchups of %s:":                     "%sの 相性:",
+	"Weak to:":                            "弱点:",
+	"Resists:":                            "いまひとつ:",
+	"Immune to:":                          "効果なし:",
+	"no effect":                           "効果なし",
+	"normal damage":                       "通常の ダメージ",
+	"not very effective":                  "いまひとつ",
+	"super effective":                     "効果は ばつぐん",
+	"Moves of %s in %s:":                  "%sの わざ (%s):",
+	"Moves of %s:":                        "%sの わざ:",
+	"No moves found.":                     "わざが 見つかりません。",
+	"Random seed: %d":                     "乱数シード: %d",
+	"Usage:":                              "使い方:",
+	"Welcome to the Pokedex!":             "ポケモン図鑑へ ようこそ!",
+	"lv %d":                               "Lv.%d",
+	"lv %d-%d":                            "Lv.%d-%d",
+	"level %d":                            "レベル %d",
+	"level up":                            "レベルアップ",
+	"use %s":                              "%sを 使う",
+	"trade":                               "通信交換",
+	"happiness %d+":                       "なつき度 %d+",
+	"affection %d+":                       "なかよし度 %d+",
+	"beauty %d+":                          "うつくしさ %d+",
+	"holding %s":                          "%sを 持たせて",
+	"knowing %s":                          "%sを 覚えて",
+	"knowing a %s move":                   "%sタイプの わざを 覚えて",
+	"at %s":                               "%sで",
+	"for %s":                              "%sと",
+	"with %s in the party":                "手持ちに %sが いる",
+	"with a %s type in the party":         "手持ちに %sタイプが いる",
+	"female":                              "メス",
+	"male":                                "オス",
+	"attack > defense":                    "こうげき > ぼうぎょ",
+	"attack < defense":                    "こうげき < ぼうぎょ",
+	"in the rain":                         "雨の中で",
+	"upside down":                         "本体を 逆さにして",
+	"day":                                 "昼",
+	"dusk":                                "夕方",
+	"night":                               "夜",
+	"needs %s, which your bag can't hold": "%sが 必要ですが バッグに 入れられません",
+	"needs happiness %d (it has %d)":      "なつき度 %d が 必要です (現在 %d)",
+	"needs level %d (it is level %d)":     "レベル %d が 必要です (現在 レベル %d)",
+	"needs level %d, but only pokemon caught in game mode have a level": "レベル %d が 必要ですが レベルが あるのは ゲームモードで 捕まえた ポケモンだけです",
+	"needs to %s": "条件: %s",
+	"needs to %s, which the Pokedex can't check":                        "条件: %s (図鑑では 確認できません)",
+	"needs to be traded":                                                "通信交換が 必要です",
+	"needs to know %s":                                                  "%sを 覚えている 必要が あります",
+	"needs to level up at %s":                                           "%sで レベルアップする 必要が あります",
+	"could not add %s to pokedex - %w":                                  "%sを 図鑑に 追加できませんでした - %w",
+	"could not download sprite - %w":                                    "スプライトを ダウンロードできませんでした - %w",
+	"could not encode result as json - %w":                              "結果を JSON に 変換できませんでした - %w",
+	"could not explore area - %w":                                       "エリアを 探索できませんでした - %w",
+	"could not fetch location area index - %w":                          "エリアの 一覧を 取得できませんでした - %w",
+	"could not find ability - %w":                                       "特性が 見つかりません - %w",
+	"could not find encounters of %s - %w":                              "%sの 出現場所が 見つかりません - %w",
+	"could not find form %s - %w":                                       "フォルム %s が 見つかりません - %w",
+	"could not find move - %w":                                          "わざが 見つかりません - %w",
+	"could not find pokemon - %w":                                       "ポケモンが 見つかりません - %w",
+	"could not list areas - %w":                                         "エリアを 一覧表示できませんでした - %w",
+	"could not list locations - %w":                                     "場所を 一覧表示できませんでした - %w",
+	"could not list regions - %w":                                       "地方を 一覧表示できませんでした - %w",
+	"could not look up %s - %w":                                         "%sを 調べられませんでした - %w",
+	"could not look up evolutions of %s - %w":                           "%sの 進化を 調べられませんでした - %w",
+	"could not look up form %s - %w":                                    "フォルム %s を 調べられませんでした - %w",
+	"could not look up species of %s - %w":                              "%sの 種族を 調べられませんでした - %w",
+	"could not look up the moves of %s - %w":                            "%sの わざを 調べられませんでした - %w",
+	"could not look up type %s - %w":                                    "タイプ %s を 調べられませんでした - %w",
+	"could not travel to %s - %w":                                       "%sに 移動できませんでした - %w",
+	"could not walk through %s - %w":                                    "%sを 歩けませんでした - %w",
+	"forms only have sprites from the latest games":                     "フォルムには 最新作の スプライトしか ありません",
+	"invalid seed %q - expected a whole number":                         "無効な シード %q - 整数を 指定してください",
+	"missing ability":                                                   "特性が 指定されていません",
+	"missing area - use 'areas <location>' to find one":                 "エリアが 指定されていません - 'areas <location>' で 探してください",
+	"missing location - use 'locations <region>' to list them":          "場所が 指定されていません - 'locations <region>' で 一覧を 表示します",
+	"missing move":                                                      "わざが 指定されていません",
+	"missing pokemon":                                                   "ポケモンが 指定されていません",
+	"missing region - use 'regions' to list them":                       "地方が 指定されていません - 'regions' で 一覧を 表示します",
+	"missing search term":                                               "検索語が 指定されていません",
+	"there is no pokemon or type called %s":                             "%s という ポケモンや タイプは いません",
+	"unknown argument %q - expected restock":                            "不明な 引数 %q - restock を 指定してください",
+	"unknown generation %q - expected one of %s":                        "不明な 世代 %q - %s の いずれかを 指定してください",
+	"usage of %s:\n%s":                                                  "%s の 使い方:\n%s",
+	"usage: matchup <pokemon|type> or matchup <attacker> vs <defender>": "使い方: matchup <pokemon|type> または matchup <attacker> vs <defender>",
+	"%s cries out!":                                                     "%sは 鳴き声を あげた!",
+	"Saved the cry of %s to %s":                                         "%sの 鳴き声を %s に 保存しました",
+	"%s has no cry":                                                     "%sには 鳴き声が ありません",
+	"%s has no legacy cry":                                              "%sには 初代の 鳴き声が ありません",
+	"could not download cry - %w":                                       "鳴き声を ダウンロードできませんでした - %w",
+	"could not save cry - %w":                                           "鳴き声を 保存できませんでした - %w",
+	"invalid cry_player - %w":                                           "cry_player が 無効です - %w",
+	"no player for cries - set cry_player, e.g. 'config set cry_player ffplay -nodisp -autoexit', or use --save <file>": "鳴き声の プレーヤーが ありません - cry_player を 設定するか (例: 'config set cry_player ffplay -nodisp -autoexit')、--save <file> を 使ってください",
+}
@@ -0,0 +1,252 @@
+package i18n
+
+// ko translates the messages to Korean.
+var ko = map[string]string{
+	"page %d of %d":                          "%d / %d 페이지",
+	"Exploring %s...":                        "%s 탐색 중...",
+	"Version: %s":                            "버전: %s",
+	"Found Pokemon:":                         "발견한 포켓몬:",
+	"Encounter rates:":                       "조우율:",
+	"Pokedex is empty!":                      "도감이 비어 있습니다!",
+	"Name: %s":                               "이름: %s",
+	"Genus: %s":                              "분류: %s",
+	"Form: %s":                               "폼: %s",
+	"Level: %v":                              "레벨: %v",
+	"Height: %v":                             "키: %v",
+	"Weight: %v":                             "몸무게: %v",
+	"Stats:":                                 "능력치:",
+	"Types:":                                 "타입:",
+	"Abilities:":                             "특성:",
+	"(hidden)":                               "(숨겨진 특성)",
+	"Pokedex entry (%s):":                    "도감 설명 (%s):",
+	"Habitat: %s":                            "서식지: %s",
+	"Color: %s":                              "색깔: %s",
+	"Shape: %s":                              "모양: %s",
+	"Generation: %s":                         "세대: %s",
+	"Gender: %s":                             "성별: %s",
+	"genderless":                             "무성",
+	"%g%% female, %g%% male":                 "암컷 %g%%, 수컷 %g%%",
+	"Legendary Pokemon":                      "전설의 포켓몬",
+	"Mythical Pokemon":                       "환상의 포켓몬",
+	"Some names could not be translated: %s": "일부 이름을 번역하지 못했습니다: %s",
+	"Could not perform command: %v":          "명령을 실행할 수 없습니다: %v",
+	"Unknown command. Type 'help' for a list of commands.": "알 수 없는 명령입니다. 'help'를 입력하면 명령 목록이 표시됩니다.",
+	"Exiting":                         "종료합니다",
+	"Already on the last page":        "이미 마지막 페이지입니다",
+	"Already on Page 1":               "이미 1페이지입니다",
+	"Capture rate of %s: %v":          "%s의 포획률: %v",
+	"Chance of success: %.1f percent": "성공 확률: %.1f 퍼센트",
+	"Throwing a %s at %s...":          "%s을(를) %s에게 던졌다...",
+	"Result: %s You caught %v!":       "결과: %s %v을(를) 잡았다!",
+	"Success!":                        "성공!",
+	"Oh no!":                          "이런!",
+	"Result: %s %v slipped away!":     "결과: %s %v이(가) 도망쳤다!",
+	"%s It's a shiny %s!":             "%s 빛나는 %s이다!",
+	"%s left: %d":                     "남은 %s: %d",
+	"Current Pokedex:":                "현재 도감:",
+	"You walk through %s...":          "%s을(를) 걷고 있다...",
+	"You search %s with %s...":        "%s에서 %s(으)로 찾고 있다...",
+	"Nothing appeared.":               "아무것도 나타나지 않았다.",
+	"A wild %s (lv %d) appeared!":     "야생의 %s (Lv. %d)이(가) 나타났다!",
+	"Go, %s!":                         "가라, %s!",
+	"%s leads your team.":             "%s이(가) 팀의 선두입니다.",
+	"You have no lead pokemon yet - catch one first.": "아직 선두 포켓몬이 없습니다 - 먼저 잡으세요.",
+	"%s (lv %d) vs wild %s (lv %d)":                   "%s (Lv. %d) 대 야생의 %s (Lv. %d)",
+	"%s used %s!":                                     "%s의 %s!",
+	"%s used %s, but it missed!":                      "%s의 %s! 그러나 빗나갔다!",
+	"It doesn't affect %s...":                         "%s에게는 효과가 없는 것 같다...",
+	"A critical hit!":                                 "급소에 맞았다!",
+	"It's super effective!":                           "효과가 굉장했다!",
+	"It's not very effective...":                      "효과가 별로인 듯하다...",
+	"%s lost %d HP.":                                  "%s은(는) HP를 %d 잃었다.",
+	"The wild %s fainted!":                            "야생의 %s은(는) 쓰러졌다!",
+	"You won!":                                        "이겼다!",
+	"%s fainted!":                                     "%s은(는) 쓰러졌다!",
+	"The wild %s got away.":                           "야생의 %s은(는) 도망쳤다.",
+	"But it failed!":                                  "하지만 실패했다!",
+	"%s was burned!":                                  "%s은(는) 화상을 입었다!",
+	"%s was frozen solid!":                            "%s은(는) 얼어붙었다!",
+	"%s is paralyzed!":                                "%s은(는) 마비되었다!",
+	"%s was poisoned!":                                "%s은(는) 독에 걸렸다!",
+	"%s fell asleep!":                                 "%s은(는) 잠들어 버렸다!",
+	"%s is frozen solid!":                             "%s은(는) 얼어버려서 움직일 수 없다!",
+	"%s is paralyzed! It can't move!":                 "%s은(는) 몸이 저려서 움직일 수 없다!",
+	"%s is fast asleep.":                              "%s은(는) 쿨쿨 잠들어 있다.",
+	"%s thawed out!":                                  "%s의 얼음 상태가 나았다!",
+	"%s woke up!":                                     "%s은(는) 눈을 떴다!",
+	"%s is hurt by its burn and lost %d HP.":          "%s은(는) 화상 데미지를 입어 %d HP를 잃었다.",
+	"%s is hurt by poison and lost %d HP.":            "%s은(는) 독에 의한 데미지를 입어 %d HP를 잃었다.",
+	"Your pokemon are fully healed.":                  "포켓몬이 모두 회복되었습니다.",
+	"%s has fainted - use 'heal' or choose another lead with 'lead <pokemon>'": "%s은(는) 쓰러졌습니다 - 'heal'을 사용하거나 'lead <pokemon>'으로 다른 선두를 고르세요",
+	"Keep fighting with 'fight [move]' or throw a ball with 'catch'.":          "'fight [move]'로 계속 싸우거나 'catch'로 볼을 던지세요.",
+	"Note: %s": "참고: %s",
+	"cache_ttl applies the next time the Pokedex starts":          "cache_ttl은 다음에 도감을 시작할 때 적용됩니다",
+	"%s is set and overrides this value on the next start":        "%s이(가) 설정되어 있어 다음 시작 시 이 값을 덮어씁니다",
+	"limit must be a positive number":                             "limit은 양수여야 합니다",
+	"unknown argument %q - expected first, last or search":        "알 수 없는 인수 %q - first, last 또는 search를 사용하세요",
+	"there are only %d pages":                                     "페이지는 %d개뿐입니다",
+	"page must be at least 1":                                     "페이지는 1 이상이어야 합니다",
+	"missing argument - give an area or 'goto' one first":         "인수가 없습니다 - 지역을 지정하거나 먼저 'goto'로 이동하세요",
+	"empty location given":                                        "장소가 비어 있습니다",
+	"there is no wild pokemon here - use 'walk' to look for one":  "여기에는 야생 포켓몬이 없습니다 - 'walk'로 찾아보세요",
+	"there is no wild %s here, only a wild %s":                    "여기에는 야생 %s이(가) 없고 야생 %s만 있습니다",
+	"wild pokemon can't be caught in another form":                "야생 포켓몬은 다른 폼으로 잡을 수 없습니다",
+	"catch command missing arguments":                             "catch 명령에 인수가 없습니다",
+	"pokemon %s already in pokedex":                               "%s은(는) 이미 도감에 있습니다",
+	"you have no %s left - see 'inventory'":                       "남은 %s이(가) 없습니다 - 'inventory'를 확인하세요",
+	"unknown ball %q - expected one of %s":                        "알 수 없는 볼 %q - %s 중 하나를 사용하세요",
+	"%s has no other forms":                                       "%s에게는 다른 폼이 없습니다",
+	"%s has no form %s - expected one of %s":                      "%s에게는 %s 폼이 없습니다 - %s 중 하나를 사용하세요",
+	"missing parameter":                                           "매개변수가 없습니다",
+	"you have not caught that pokemon":                            "그 포켓몬은 잡지 않았습니다",
+	"lang must be one of %s":                                      "lang은 %s 중 하나여야 합니다",
+	"you are not in an area - use 'goto <area>' first":            "지역에 있지 않습니다 - 먼저 'goto <area>'를 사용하세요",
+	"there are no wild pokemon in %s":                             "%s에는 야생 포켓몬이 없습니다",
+	"no pokemon can be found with %s here - try --method %s":      "여기서는 %s(으)로 포켓몬을 찾을 수 없습니다 - --method %s를 시도하세요",
+	"you have not caught %s":                                      "%s을(를) 잡지 않았습니다",
+	"you have no pokemon to fight with - catch one first":         "싸울 포켓몬이 없습니다 - 먼저 잡으세요",
+	"you have no lead pokemon - choose one with 'lead <pokemon>'": "선두 포켓몬이 없습니다 - 'lead <pokemon>'으로 고르세요",
+	"%s can't attack with %s - it knows %s":                       "%s은(는) %s(으)로 공격할 수 없습니다 - 아는 기술: %s",
+	"missing subcommand - expected list, get or set":              "하위 명령이 없습니다 - list, get 또는 set을 사용하세요",
+	"missing setting name":                                        "설정 이름이 없습니다",
+	"usage: config set <key> <value>":                             "사용법: config set <key> <value>",
+	"unknown subcommand %q - expected list, get or set":           "알 수 없는 하위 명령 %q - list, get 또는 set을 사용하세요",
+	" or ":            " 또는 ",
+	"%v, and %d more": "%v 외 %d개",
+	"Unknown command %q. Run '%s help' for a list of commands.":     "알 수 없는 명령 %q입니다. '%s help'로 명령 목록을 확인하세요.",
+	"%d matches - explore an area by name or id":                    "%d개 일치 - 이름이나 ID로 지역을 탐험하세요",
+	"1 match - explore an area by name or id":                       "1개 일치 - 이름이나 ID로 지역을 탐험하세요",
+	"%d more not shown - narrow the search or raise page_size":      "%d개 더 있음 - 검색을 좁히거나 page_size를 늘리세요",
+	"No location areas match %q.":                                   "%q와(과) 일치하는 지역이 없습니다.",
+	"%s Your %s evolved into %s!":                                   "%s %s은(는) %s(으)로 진화했다!",
+	"What? %s is evolving!":                                         "어라...!? %s의 모습이...!",
+	"Congratulations!":                                              "축하합니다!",
+	"Evolutions of %s:":                                             "%s의 진화:",
+	"%s can be found in:":                                           "%s을(를) 만날 수 있는 곳:",
+	"%s can't be found in the wild in %s.":                          "%s은(는) %s에서 야생으로 만날 수 없습니다.",
+	"%s can't be found in the wild.":                                "%s은(는) 야생으로 만날 수 없습니다.",
+	"%s can evolve into %s - choose one with 'evolve %s <pokemon>'": "%s은(는) %s(으)로 진화할 수 있습니다 - 'evolve %s <pokemon>'으로 선택하세요",
+	"%s can't evolve yet: %s":                                       "%s은(는) 아직 진화할 수 없습니다: %s",
+	"%s does not evolve any further":                                "%s은(는) 더 이상 진화하지 않습니다",
+	"%s does not evolve into %s":                                    "%s은(는) %s(으)로 진화하지 않습니다",
+	"%s has no evolutions":                                          "%s은(는) 진화하지 않습니다",
+	"%s has no such sprite":                                         "%s에게는 그런 스프라이트가 없습니다",
+	"%s has no such sprite in generation %s":                        "%s에게는 %s세대의 그런 스프라이트가 없습니다",
+	"%s moves:":                                                     "%s 타입 기술:",
+	"%s type":                                                       "%s 타입",
+	"%s vs %s:":                                                     "%s 대 %s:",
+	"(%d version groups)":                                           "(%d개 버전 그룹)",
+	"(you are here)":                                                "(현재 위치)",
+	"Accuracy: %s":                                                  "명중: %s",
+	"Category: %s":                                                  "분류: %s",
+	"Effect: %s":                                                    "효과: %s",
+	"PP: %d":                                                        "PP: %d",
+	"Power: %s":                                                     "위력: %s",
+	"Priority: %+d":                                                 "우선도: %+d",
+	"Type: %s":                                                      "타입: %s",
+	"Introduced in: %s":                                             "첫 등장: %s",
+	"Pokemon with this ability:":                                    "이 특성을 가진 포켓몬:",
+	"Bag:":                                                          "가방:",
+	"Location: %s":                                                  "장소: %s",
+	"Region: %s":                                                    "지방: %s",
+	"Regions:":                                                      "지방:",
+	"Locations in %s:":                                              "%s의 장소:",
+	"Areas in %s:":                                                  "%s의 지역:",
+	"No regions found.":                                             "지방을 찾을 수 없습니다.",
+	"No locations found.":                                           "장소를 찾을 수 없습니다.",
+	"No areas found.":                                               "지역을 찾을 수 없습니다.",
+	"Travelled to %s!":                                              "%s에 도착했다!",
+	"You are in %s.":                                                "현재 위치는 %s입니다.",
+	"You haven't travelled anywhere yet. Use 'goto <area>' to set out.": "아직 아무 곳도 가지 않았습니다. 'goto <area>'로 출발하세요.",
+	"Matchups of %s:":                     "%s의 상성:",
+	"Weak to:":                            "약점:",
+	"Resists:":                            "반감:",
+	"Immune to:":                          "무효:",
+	"no effect":                           "효과 없음",
+	"normal damage":                       "보통 데미지",
+	"not very effective":                  "효과가 별로",
+	"super effective":                     "효과가 굉장함",
+	"Moves of %s in %s:":                  "%s의 기술 (%s):",
+	"Moves of %s:":                        "%s의 기술:",
+	"No moves found.":                     "기술을 찾을 수 없습니다.",
+	"Random seed: %d":                     "난수 시드: %d",
+	"Usage:":                              "사용법:",
+	"Welcome to the Pokedex!":             "포켓몬 도감에 오신 것을 환영합니다!",
+	"lv %d":                               "Lv.%d",
+	"lv %d-%d":                            "Lv.%d-%d",
+	"level %d":                            "레벨 %d",
+	"level up":                            "레벨 업",
+	"use %s":                              "%s 사용",
+	"trade":                               "통신교환",
+	"happiness %d+":                       "친밀도 %d+",
+	"affection %d+":                       "절친도 %d+",
+	"beauty %d+":                          "아름다움 %d+",
+	"holding %s":                          "%s을(를) 지니고",
+	"knowing %s":                          "%s을(를) 배운 상태로",
+	"knowing a %s move":                   "%s 타입 기술을 배운 상태로",
+	"at %s":                               "%s에서",
+	"for %s":                              "%s와(과)",
+	"with %s in the party":                "파티에 %s이(가) 있을 때",
+	"with a %s type in the party":         "파티에 %s 타입이 있을 때",
+	"female":                              "암컷",
+	"male":                                "수컷",
+	"attack > defense":                    "공격 > 방어",
+	"attack < defense":                    "공격 < 방어",
+	"in the rain":                         "비가 올 때",
+	"upside down":                         "본체를 뒤집어서",
+	"day":                                 "낮",
+	"dusk":                                "저녁",
+	"night":                               "밤",
+	"needs %s, which your bag can't hold": "%s이(가) 필요하지만 가방에 넣을 수 없습니다",
+	"needs happiness %d (it has %d)":      "친밀도 %d이(가) 필요합니다 (현재 %d)",
+	"needs level %d (it is level %d)":     "레벨 %d이(가) 필요합니다 (현재 레벨 %d)",
+	"needs level %d, but only pokemon caught in game mode have a level": "레벨 %d이(가) 필요하지만 게임 모드에서 잡은 포켓몬만 레벨이 있습니다",
+	"needs to %s": "조건: %s",
+	"needs to %s, which the Pokedex can't check":                        "조건: %s (도감에서 확인할 수 없습니다)",
+	"needs to be traded":                                                "통신교환이 필요합니다",
+	"needs to know %s":                                                  "%s을(를) 배우고 있어야 합니다",
+	"needs to level up at %s":                                           "%s에서 레벨 업해야 합니다",
+	"could not add %s to pokedex - %w":                                  "%s을(를) 도감에 추가할 수 없습니다 - %w",
+	"could not download sprite - %w":                                    "스프라이트를 다운로드할 수 없습니다 - %w",
+	"could not encode result as json - %w":                              "결과를 JSON으로 인코딩할 수 없습니다 - %w",
+	"could not explore area - %w":                                       "지역을 탐험할 수 없습니다 - %w",
+	"could not fetch location area index - %w":                          "지역 목록을 가져올 수 없습니다 - %w",
+	"could not find ability - %w":                                       "특성을 찾을 수 없습니다 - %w",
+	"could not find encounters of %s - %w":                              "%s의 출현 정보를 찾을 수 없습니다 - %w",
+	"could not find form %s - %w":                                       "폼 %s을(를) 찾을 수 없습니다 - %w",
+	"could not find move - %w":                                          "기술을 찾을 수 없습니다 - %w",
+	"could not find pokemon - %w":                                       "포켓몬을 찾을 수 없습니다 - %w",
+	"could not list areas - %w":                                         "지역 목록을 표시할 수 없습니다 - %w",
+	"could not list locations - %w":                                     "장소 목록을 표시할 수 없습니다 - %w",
+	"could not list regions - %w":                                       "지방 목록을 표시할 수 없습니다 - %w",
+	"could not look up %s - %w":                                         "%s을(를) 조회할 수 없습니다 - %w",
+	"could not look up evolutions of %s - %w":                           "%s의 진화를 조회할 수 없습니다 - %w",
+	"could not look up form %s - %w":                                    "폼 %s을(를) 조회할 수 없습니다 - %w",
+	"could not look up species of %s - %w":                              "%s의 종을 조회할 수 없습니다 - %w",
+	"could not look up the moves of %s - %w":                            "%s의 기술을 조회할 수 없습니다 - %w",
+	"could not look up type %s - %w":                                    "타입 %s을(를) 조회할 수 없습니다 - %w",
+	"could not travel to %s - %w":                                       "%s(으)로 이동할 수 없습니다 - %w",
+	"could not walk through %s - %w":                                    "%s을(를) 걸을 수 없습니다 - %w",
+	"forms only have sprites from the latest games":                     "폼에는 최신작의 스프라이트만 있습니다",
+	"invalid seed %q - expected a whole number":                         "잘못된 시드 %q - 정수를 입력하세요",
+	"missing ability":                                                   "특성이 없습니다",
+	"missing area - use 'areas <location>' to find one":                 "지역이 없습니다 - 'areas <location>'으로 찾으세요",
+	"missing location - use 'locations <region>' to list them":          "장소가 없습니다 - 'locations <region>'으로 목록을 확인하세요",
+	"missing move":                                                      "기술이 없습니다",
+	"missing pokemon":                                                   "포켓몬이 없습니다",
+	"missing region - use 'regions' to list them":                       "지방이 없습니다 - 'regions'로 목록을 확인하세요",
+	"missing search term":                                               "검색어가 없습니다",
+	"there is no pokemon or type called %s":                             "%s(이)라는 포켓몬이나 타입은 없습니다",
+	"unknown argument %q - expected restock":                            "알 수 없는 인수 %q - restock을 사용하세요",
+	"unknown generation %q - expected one of %s":                        "알 수 없는 세대 %q - %s 중 하나를 사용하세요",
+	"usage of %s:\n%s":                                                  "%s 사용법:\n%s",
+	"usage: matchup <pokemon|type> or matchup <attacker> vs <defender>": "사용법: matchup <pokemon|type> 또는 matchup <attacker> vs <defender>",
+	"%s cries out!":                                                     "%s이(가) 울음소리를 냈다!",
+	"Saved the cry of %s to %s":                                         "%s의 울음소리를 %s에 저장했습니다",
+	"%s has no cry":                                                     "%s에게는 울음소리가 없습니다",
+	"%s has no legacy cry":                                              "%s에게는 초대 울음소리가 없습니다",
+	"could not download cry - %w":                                       "울음소리를 다운로드할 수 없습니다 - %w",
+	"could not save cry - %w":                                           "울음소리를 저장할 수 없습니다 - %w",
+	"invalid cry_player - %w":                                           "잘못된 cry_player - %w",
+	"no player for cries - set cry_player, e.g. 'config set cry_player ffplay -nodisp -autoexit', or use --save <file>": "울음소리 플레이어가 없습니다 - cry_player를 설정하거나(예: 'config set cry_player ffplay -nodisp -autoexit') --save <file>을 사용하세요",
+}
@@ -0,0 +1,252 @@
+package i18n
+
+// zhHans translates the messages to Simplified Chinese.
+var zhHans = map[string]string{
+	"page %d of %d":                          "第 %d 页，共 %d 页",
+	"Exploring %s...":                        "正在探索%s...",
+	"Version: %s":                            "版本：%s",
+	"Found Pokemon:":                         "发现的宝可梦：",
+	"Encounter rates:":                       "遭遇率：",
+	"Pokedex is empty!":                      "图鉴是空的！",
+	"Name: %s":                               "名字：%s",
+	"Genus: %s":                              "分类：%s",
+	"Form: %s":                               "样子：%s",
+	"Level: %v":                              "等级：%v",
+	"Height: %v":                             "身高：%v",
+	"Weight: %v":                             "体重：%v",
+	"Stats:":                                 "能力：",
+	"Types:":                                 "属性：",
+	"Abilities:":                             "特性：",
+	"(hidden)":                               "(隐藏特性)",
+	"Pokedex entry (%s):":                    "图鉴介绍（%s）：",
+	"Habitat: %s":                            "栖息地：%s",
+	"Color: %s":                              "颜色：%s",
+	"Shape: %s":                              "体形：%s",
+	"Generation: %s":                         "世代：%s",
+	"Gender: %s":                             "性别：%s",
+	"genderless":                             "无性别",
+	"%g%% female, %g%% male":                 "雌性 %g%%，雄性 %g%%",
+	"Legendary Pokemon":                      "传说的宝可梦",
+	"Mythical Pokemon":                       "幻之宝可梦",
+	"Some names could not be translated: %s": "部分名称无法翻译：%s",
+	"Could not perform command: %v":          "无法执行命令：%v",
+	"Unknown command. Type 'help' for a list of commands.": "未知命令。输入 'help' 查看命令列表。",
+	"Exiting":                         "正在退出",
+	"Already on the last page":        "已经是最后一页",
+	"Already on Page 1":               "已经是第 1 页",
+	"Capture rate of %s: %v":          "%s的捕获率：%v",
+	"Chance of success: %.1f percent": "成功率：百分之 %.1f",
+	"Throwing a %s at %s...":          "扔出%s，目标是%s...",
+	"Result: %s You caught %v!":       "结果：%s 抓到了%v！",
+	"Success!":                        "成功！",
+	"Oh no!":                          "哎呀！",
+	"Result: %s %v slipped away!":     "结果：%s %v逃走了！",
+	"%s It's a shiny %s!":             "%s 是异色的%s！",
+	"%s left: %d":                     "剩余%s：%d",
+	"Current Pokedex:":                "当前图鉴：",
+	"You walk through %s...":          "你在%s中行走...",
+	"You search %s with %s...":        "你在%s用%s搜索...",
+	"Nothing appeared.":               "什么也没有出现。",
+	"A wild %s (lv %d) appeared!":     "野生的%s（Lv. %d）出现了！",
+	"Go, %s!":                         "去吧，%s！",
+	"%s leads your team.":             "%s是你队伍的领头。",
+	"You have no lead pokemon yet - catch one first.": "你还没有领头的宝可梦 - 先抓一只吧。",
+	"%s (lv %d) vs wild %s (lv %d)":                   "%s（Lv. %d）对 野生的%s（Lv. %d）",
+	"%s used %s!":                                     "%s使用了%s！",
+	"%s used %s, but it missed!":                      "%s使用了%s，但是没有命中！",
+	"It doesn't affect %s...":                         "好像对%s没有效果...",
+	"A critical hit!":                                 "击中要害！",
+	"It's super effective!":                           "效果绝佳！",
+	"It's not very effective...":                      "效果不好...",
+	"%s lost %d HP.":                                  "%s失去了 %d HP。",
+	"The wild %s fainted!":                            "野生的%s倒下了！",
+	"You won!":                                        "你赢了！",
+	"%s fainted!":                                     "%s倒下了！",
+	"The wild %s got away.":                           "野生的%s逃走了。",
+	"But it failed!":                                  "但是失败了！",
+	"%s was burned!":                                  "%s被灼伤了！",
+	"%s was frozen solid!":                            "%s被冻住了！",
+	"%s is paralyzed!":                                "%s麻痹了！",
+	"%s was poisoned!":                                "%s中毒了！",
+	"%s fell asleep!":                                 "%s睡着了！",
+	"%s is frozen solid!":                             "%s因冻住而无法行动！",
+	"%s is paralyzed! It can't move!":                 "%s因麻痹而无法行动！",
+	"%s is fast asleep.":                              "%s正在呼呼大睡。",
+	"%s thawed out!":                                  "%s的冰冻解除了！",
+	"%s woke up!":                                     "%s醒过来了！",
+	"%s is hurt by its burn and lost %d HP.":          "%s受到灼伤的伤害，失去了%d HP。",
+	"%s is hurt by poison and lost %d HP.":            "%s受到毒的伤害，失去了%d HP。",
+	"Your pokemon are fully healed.":                  "你的宝可梦都恢复健康了。",
+	"%s has fainted - use 'heal' or choose another lead with 'lead <pokemon>'": "%s已经倒下了 - 使用 'heal'，或用 'lead <pokemon>' 选择其他领头",
+	"Keep fighting with 'fight [move]' or throw a ball with 'catch'.":          "用 'fight [move]' 继续战斗，或用 'catch' 扔出精灵球。",
+	"Note: %s": "注意：%s",
+	"cache_ttl applies the next time the Pokedex starts":          "cache_ttl 在下次启动图鉴时生效",
+	"%s is set and overrides this value on the next start":        "已设置 %s，下次启动时会覆盖此值",
+	"limit must be a positive number":                             "limit 必须是正数",
+	"unknown argument %q - expected first, last or search":        "未知参数 %q - 应为 first、last 或 search",
+	"there are only %d pages":                                     "只有 %d 页",
+	"page must be at least 1":                                     "页码至少为 1",
+	"missing argument - give an area or 'goto' one first":         "缺少参数 - 请指定区域，或先用 'goto' 前往一个区域",
+	"empty location given":                                        "地点为空",
+	"there is no wild pokemon here - use 'walk' to look for one":  "这里没有野生宝可梦 - 用 'walk' 寻找",
+	"there is no wild %s here, only a wild %s":                    "这里没有野生的%s，只有野生的%s",
+	"wild pokemon can't be caught in another form":                "野生宝可梦无法以其他样子捕捉",
+	"catch command missing arguments":                             "catch 命令缺少参数",
+	"pokemon %s already in pokedex":                               "%s已经在图鉴里了",
+	"you have no %s left - see 'inventory'":                       "你没有%s了 - 请查看 'inventory'",
+	"unknown ball %q - expected one of %s":                        "未知的球 %q - 应为 %s 之一",
+	"%s has no other forms":                                       "%s没有其他样子",
+	"%s has no form %s - expected one of %s":                      "%s没有%s样子 - 应为 %s 之一",
+	"missing parameter":                                           "缺少参数",
+	"you have not caught that pokemon":                            "你还没有抓到那只宝可梦",
+	"lang must be one of %s":                                      "lang 必须是 %s 之一",
+	"you are not in an area - use 'goto <area>' first":            "你不在任何区域 - 请先使用 'goto <area>'",
+	"there are no wild pokemon in %s":                             "%s没有野生宝可梦",
+	"no pokemon can be found with %s here - try --method %s":      "这里用%s找不到宝可梦 - 试试 --method %s",
+	"you have not caught %s":                                      "你还没有抓到%s",
+	"you have no pokemon to fight with - catch one first":         "你没有可以战斗的宝可梦 - 先抓一只吧",
+	"you have no lead pokemon - choose one with 'lead <pokemon>'": "你没有领头的宝可梦 - 用 'lead <pokemon>' 选择一只",
+	"%s can't attack with %s - it knows %s":                       "%s不能用%s攻击 - 它会的招式：%s",
+	"missing subcommand - expected list, get or set":              "缺少子命令 - 应为 list、get 或 set",
+	"missing setting name":                                        "缺少设置名称",
+	"usage: config set <key> <value>":                             "用法：config set <key> <value>",
+	"unknown subcommand %q - expected list, get or set":           "未知子命令 %q - 应为 list、get 或 set",
+	" or ":            " 或 ",
+	"%v, and %d more": "%v，另外还有 %d 个",
+	"Unknown command %q. Run '%s help' for a list of commands.":     "未知命令 %q。运行 '%s help' 查看命令列表。",
+	"%d matches - explore an area by name or id":                    "%d 个结果 - 按名称或 ID 探索区域",
+	"1 match - explore an area by name or id":                       "1 个结果 - 按名称或 ID 探索区域",
+	"%d more not shown - narrow the search or raise page_size":      "还有 %d 个未显示 - 请缩小搜索范围或增大 page_size",
+	"No location areas match %q.":                                   "没有与 %q 匹配的区域。",
+	"%s Your %s evolved into %s!":                                   "%s 你的%s进化成了%s！",
+	"What? %s is evolving!":                                         "咦？%s的样子……！",
+	"Congratulations!":                                              "恭喜！",
+	"Evolutions of %s:":                                             "%s的进化：",
+	"%s can be found in:":                                           "%s可以在以下地点找到：",
+	"%s can't be found in the wild in %s.":                          "%s在%s中无法在野外找到。",
+	"%s can't be found in the wild.":                                "%s无法在野外找到。",
+	"%s can evolve into %s - choose one with 'evolve %s <pokemon>'": "%s可以进化成%s - 请用 'evolve %s <pokemon>' 选择一个",
+	"%s can't evolve yet: %s":                                       "%s还不能进化：%s",
+	"%s does not evolve any further":                                "%s不会再进化了",
+	"%s does not evolve into %s":                                    "%s不会进化成%s",
+	"%s has no evolutions":                                          "%s没有进化",
+	"%s has no such sprite":                                         "%s没有这种图像",
+	"%s has no such sprite in generation %s":                        "%s在第 %s 世代没有这种图像",
+	"%s moves:":                                                     "%s属性招式：",
+	"%s type":                                                       "%s属性",
+	"%s vs %s:":                                                     "%s 对 %s：",
+	"(%d version groups)":                                           "(%d 个版本组)",
+	"(you are here)":                                                "(你在这里)",
+	"Accuracy: %s":                                                  "命中：%s",
+	"Category: %s":                                                  "分类：%s",
+	"Effect: %s":                                                    "效果：%s",
+	"PP: %d":                                                        "PP：%d",
+	"Power: %s":                                                     "威力：%s",
+	"Priority: %+d":                                                 "优先度：%+d",
+	"Type: %s":                                                      "属性：%s",
+	"Introduced in: %s":                                             "首次登场：%s",
+	"Pokemon with this ability:":                                    "拥有该特性的宝可梦：",
+	"Bag:":                                                          "背包：",
+	"Location: %s":                                                  "地点：%s",
+	"Region: %s":                                                    "地区：%s",
+	"Regions:":                                                      "地区：",
+	"Locations in %s:":                                              "%s的地点：",
+	"Areas in %s:":                                                  "%s的区域：",
+	"No regions found.":                                             "没有找到地区。",
+	"No locations found.":                                           "没有找到地点。",
+	"No areas found.":                                               "没有找到区域。",
+	"Travelled to %s!":                                              "来到了%s！",
+	"You are in %s.":                                                "你在%s。",
+	"You haven't travelled anywhere yet. Use 'goto <area>' to set out.": "你还没有去过任何地方。用 'goto <area>' 出发吧。",
+	"Matchups of %s:":                     "%s的属性相克：",
+	"Weak to:":                            "弱点：",
+	"Resists:":                            "抵抗：",
+	"Immune to:":                          "免疫：",
+	"no effect":                           "没有效果",
+	"normal damage":                       "普通伤害",
+	"not very effective":                  "效果不好",
+	"super effective":                     "效果绝佳",
+	"Moves of %s in %s:":                  "%s的招式（%s）：",
+	"Moves of %s:":                        "%s的招式：",
+	"No moves found.":                     "没有找到招式。",
+	"Random seed: %d":                     "随机种子：%d",
+	"Usage:":                              "用法：",
+	"Welcome to the Pokedex!":             "欢迎使用宝可梦图鉴！",
+	"lv %d":                               "Lv.%d",
+	"lv %d-%d":                            "Lv.%d-%d",
+	"level %d":                            "等级 %d",
+	"level up":                            "升级",
+	"use %s":                              "使用%s",
+	"trade":                               "连接交换",
+	"happiness %d+":                       "亲密度 %d+",
+	"affection %d+":                       "友好度 %d+",
+	"beauty %d+":                          "美丽 %d+",
+	"holding %s":                          "携带%s",
+	"knowing %s":                          "学会%s",
+	"knowing a %s move":                   "学会%s属性招式",
+	"at %s":                               "在%s",
+	"for %s":                              "与%s",
+	"with %s in the party":                "队伍中有%s",
+	"with a %s type in the party":         "队伍中有%s属性宝可梦",
+	"female":                              "雌性",
+	"male":                                "雄性",
+	"attack > defense":                    "攻击 > 防御",
+	"attack < defense":                    "攻击 < 防御",
+	"in the rain":                         "下雨时",
+	"upside down":                         "倒置主机",
+	"day":                                 "白天",
+	"dusk":                                "黄昏",
+	"night":                               "夜晚",
+	"needs %s, which your bag can't hold": "需要%s，但你的背包装不下",
+	"needs happiness %d (it has %d)":      "需要亲密度 %d（当前为 %d）",
+	"needs level %d (it is level %d)":     "需要等级 %d（当前为 %d 级）",
+	"needs level %d, but only pokemon caught in game mode have a level": "需要等级 %d，但只有在游戏模式中捕捉的宝可梦才有等级",
+	"needs to %s": "需要：%s",
+	"needs to %s, which the Pokedex can't check":                        "需要：%s，图鉴无法检查",
+	"needs to be traded":                                                "需要连接交换",
+	"needs to know %s":                                                  "需要学会%s",
+	"needs to level up at %s":                                           "需要在%s升级",
+	"could not add %s to pokedex - %w":                                  "无法将%s加入图鉴 - %w",
+	"could not download sprite - %w":                                    "无法下载图像 - %w",
+	"could not encode result as json - %w":                              "无法将结果编码为 JSON - %w",
+	"could not explore area - %w":                                       "无法探索区域 - %w",
+	"could not fetch location area index - %w":                          "无法获取区域索引 - %w",
+	"could not find ability - %w":                                       "找不到特性 - %w",
+	"could not find encounters of %s - %w":                              "找不到%s的出现地点 - %w",
+	"could not find form %s - %w":                                       "找不到形态 %s - %w",
+	"could not find move - %w":                                          "找不到招式 - %w",
+	"could not find pokemon - %w":                                       "找不到宝可梦 - %w",
+	"could not list areas - %w":                                         "无法列出区域 - %w",
+	"could not list locations - %w":                                     "无法列出地点 - %w",
+	"could not list regions - %w":                                       "无法列出地区 - %w",
+	"could not look up %s - %w":                                         "无法查询%s - %w",
+	"could not look up evolutions of %s - %w":                           "无法查询%s的进化 - %w",
+	"could not look up form %s - %w":                                    "无法查询形态 %s - %w",
+	"could not look up species of %s - %w":                              "无法查询%s的种类 - %w",
+	"could not look up the moves of %s - %w":                            "无法查询%s的招式 - %w",
+	"could not look up type %s - %w":                                    "无法查询属性 %s - %w",
+	"could not travel to %s - %w":                                       "无法前往%s - %w",
+	"could not walk through %s - %w":                                    "无法在%s中行走 - %w",
+	"forms only have sprites from the latest games":                     "形态只有最新游戏的图像",
+	"invalid seed %q - expected a whole number":                         "无效的种子 %q - 应为整数",
+	"missing ability":                                                   "缺少特性",
+	"missing area - use 'areas <location>' to find one":                 "缺少区域 - 用 'areas <location>' 查找",
+	"missing location - use 'locations <region>' to list them":          "缺少地点 - 用 'locations <region>' 列出",
+	"missing move":                                                      "缺少招式",
+	"missing pokemon":                                                   "缺少宝可梦",
+	"missing region - use 'regions' to list them":                       "缺少地区 - 用 'regions' 列出",
+	"missing search term":                                               "缺少搜索词",
+	"there is no pokemon or type called %s":                             "没有名为 %s 的宝可梦或属性",
+	"unknown argument %q - expected restock":                            "未知参数 %q - 应为 restock",
+	"unknown generation %q - expected one of %s":                        "未知世代 %q - 应为 %s 之一",
+	"usage of %s:\n%s":                                                  "%s 的用法：\n%s",
+	"usage: matchup <pokemon|type> or matchup <attacker> vs <defender>": "用法：matchup <pokemon|type> 或 matchup <attacker> vs <defender>",
+	"%s cries out!":                                                     "%s发出了叫声！",
+	"Saved the cry of %s to %s":                                         "已将%s的叫声保存到 %s",
+	"%s has no cry":                                                     "%s没有叫声",
+	"%s has no legacy cry":                                              "%s没有初代叫声",
+	"could not download cry - %w":                                       "无法下载叫声 - %w",
+	"could not save cry - %w":                                           "无法保存叫声 - %w",
+	"invalid cry_player - %w":                                           "无效的 cry_player - %w",
+	"no player for cries - set cry_player, e.g. 'config set cry_player ffplay -nodisp -autoexit', or use --save <file>": "没有叫声播放器 - 请设置 cry_player，例如 'config set cry_player ffplay -nodisp -autoexit'，或使用 --save <file>",
+}
@@ -0,0 +1,252 @@
+package i18n
+
+// zhHant translates the messages to Traditional Chinese.
+var zhHant = map[string]string{
+	"page %d of %d":                          "第 %d 頁，共 %d 頁",
+	"Exploring %s...":                        "正在探索%s...",
+	"Version: %s":                            "版本：%s",
+	"Found Pokemon:":                         "發現的寶可夢：",
+	"Encounter rates:":                       "遭遇率：",
+	"Pokedex is empty!":                      "圖鑑是空的！",
+	"Name: %s":                               "名字：%s",
+	"Genus: %s":                              "分類：%s",
+	"Form: %s":                               "樣子：%s",
+	"Level: %v":                              "等級：%v",
+	"Height: %v":                             "身高：%v",
+	"Weight: %v":                             "體重：%v",
+	"Stats:":                                 "能力：",
+	"Types:":                                 "屬性：",
+	"Abilities:":                             "特性：",
+	"(hidden)":                               "(隱藏特性)",
+	"Pokedex entry (%s):":                    "圖鑑介紹（%s）：",
+	"Habitat: %s":                            "棲息地：%s",
+	"Color: %s":                              "顏色：%s",
+	"Shape: %s":                              "體形：%s",
+	"Generation: %s":                         "世代：%s",
+	"Gender: %s":                             "性別：%s",
+	"genderless":                             "無性別",
+	"%g%% female, %g%% male":                 "雌性 %g%%，雄性 %g%%",
+	"Legendary Pokemon":                      "傳說的寶可夢",
+	"Mythical Pokemon":                       "幻之寶可夢",
+	"Some names could not be translated: %s": "部分名稱無法翻譯：%s",
+	"Could not perform command: %v":          "無法執行命令：%v",
+	"Unknown command. Type 'help' for a list of commands.": "未知命令。輸入 'help' 查看命令列表。",
+	"Exiting":                         "正在退出",
+	"Already on the last page":        "已經是最後一頁",
+	"Already on Page 1":               "已經是第 1 頁",
+	"Capture rate of %s: %v":          "%s的捕獲率：%v",
+	"Chance of success: %.1f percent": "成功率：百分之 %.1f",
+	"Throwing a %s at %s...":          "扔出%s，目標是%s...",
+	"Result: %s You caught %v!":       "結果：%s 抓到了%v！",
+	"Success!":                        "成功！",
+	"Oh no!":                          "哎呀！",
+	"Result: %s %v slipped away!":     "結果：%s %v逃走了！",
+	"%s It's a shiny %s!":             "%s 是異色的%s！",
+	"%s left: %d":                     "剩餘%s：%d",
+	"Current Pokedex:":                "目前圖鑑：",
+	"You walk through %s...":          "你在%s中行走...",
+	"You search %s with %s...":        "你在%s用%s搜索...",
+	"Nothing appeared.":               "什麼也沒有出現。",
+	"A wild %s (lv %d) appeared!":     "野生的%s（Lv. %d）出現了！",
+	"Go, %s!":                         "去吧，%s！",
+	"%s leads your team.":             "%s是你隊伍的領頭。",
+	"You have no lead pokemon yet - catch one first.": "你還沒有領頭的寶可夢 - 先抓一隻吧。",
+	"%s (lv %d) vs wild %s (lv %d)":                   "%s（Lv. %d）對 野生的%s（Lv. %d）",
+	"%s used %s!":                                     "%s使用了%s！",
+	"%s used %s, but it missed!":                      "%s使用了%s，但是沒有命中！",
+	"It doesn't affect %s...":                         "好像對%s沒有效果...",
+	"A critical hit!":                                 "擊中要害！",
+	"It's super effective!":                           "效果絕佳！",
+	"It's not very effective...":                      "效果不好...",
+	"%s lost %d HP.":                                  "%s失去了 %d HP。",
+	"The wild %s fainted!":                            "野生的%s倒下了！",
+	"You won!":                                        "你贏了！",
+	"%s fainted!":                                     "%s倒下了！",
+	"The wild %s got away.":                           "野生的%s逃走了。",
+	"But it failed!":                                  "但是失敗了！",
+	"%s was burned!":                                  "%s被灼傷了！",
+	"%s was frozen solid!":                            "%s被凍住了！",
+	"%s is paralyzed!":                                "%s麻痺了！",
+	"%s was poisoned!":                                "%s中毒了！",
+	"%s fell asleep!":                                 "%s睡著了！",
+	"%s is frozen solid!":                             "%s因凍住而無法行動！",
+	"%s is paralyzed! It can't move!":                 "%s因麻痺而無法行動！",
+	"%s is fast asleep.":                              "%s正在呼呼大睡。",
+	"%s thawed out!":                                  "%s的冰凍解除了！",
+	"%s woke up!":                                     "%s醒過來了！",
+	"%s is hurt by its burn and lost %d HP.":          "%s受到灼傷的傷害，失去了%d HP。",
+	"%s is hurt by poison and lost %d HP.":            "%s受到毒的傷害，失去了%d HP。",
+	"Your pokemon are fully healed.":                  "你的寶可夢都恢復健康了。",
+	"%s has fainted - use 'heal' or choose another lead with 'lead <pokemon>'": "%s已經倒下了 - 使用 'heal'，或用 'lead <pokemon>' 選擇其他領頭",
+	"Keep fighting with 'fight [move]' or throw a ball with 'catch'.":          "用 'fight [move]' 繼續戰鬥，或用 'catch' 扔出精靈球。",
+	"Note: %s": "注意：%s",
+	"cache_ttl applies the next time the Pokedex starts":          "cache_ttl 在下次啟動圖鑑時生效",
+	"%s is set and overrides this value on the next start":        "已設定 %s，下次啟動時會覆蓋此值",
+	"limit must be a positive number":                             "limit 必須是正數",
+	"unknown argument %q - expected first, last or search":        "未知參數 %q - 應為 first、last 或 search",
+	"there are only %d pages":                                     "只有 %d 頁",
+	"page must be at least 1":                                     "頁碼至少為 1",
+	"missing argument - give an area or 'goto' one first":         "缺少參數 - 請指定區域，或先用 'goto' 前往一個區域",
+	"empty location given":                                        "地點為空",
+	"there is no wild pokemon here - use 'walk' to look for one":  "這裡沒有野生寶可夢 - 用 'walk' 尋找",
+	"there is no wild %s here, only a wild %s":                    "這裡沒有野生的%s，只有野生的%s",
+	"wild pokemon can't be caught in another form":                "野生寶可夢無法以其他樣子捕捉",
+	"catch command missing arguments":                             "catch 命令缺少參數",
+	"pokemon %s already in pokedex":                               "%s已經在圖鑑裡了",
+	"you have no %s left - see 'inventory'":                       "你沒有%s了 - 請查看 'inventory'",
+	"unknown ball %q - expected one of %s":                        "未知的球 %q - 應為 %s 之一",
+	"%s has no other forms":                                       "%s沒有其他樣子",
+	"%s has no form %s - expected one of %s":                      "%s沒有%s樣子 - 應為 %s 之一",
+	"missing parameter":                                           "缺少參數",
+	"you have not caught that pokemon":                            "你還沒有抓到那隻寶可夢",
+	"lang must be one of %s":                                      "lang 必須是 %s 之一",
+	"you are not in an area - use 'goto <area>' first":            "你不在任何區域 - 請先使用 'goto <area>'",
+	"there are no wild pokemon in %s":                             "%s沒有野生寶可夢",
+	"no pokemon can be found with %s here - try --method %s":      "這裡用%s找不到寶可夢 - 試試 --method %s",
+	"you have not caught %s":                                      "你還沒有抓到%s",
+	"you have no pokemon to fight with - catch one first":         "你沒有可以戰鬥的寶可夢 - 先抓一隻吧",
+	"you have no lead pokemon - choose one with 'lead <pokemon>'": "你沒有領頭的寶可夢 - 用 'lead <pokemon>' 選擇一隻",
+	"%s can't attack with %s - it knows %s":                       "%s不能用%s攻擊 - 它會的招式：%s",
+	"missing subcommand - expected list, get or set":              "缺少子命令 - 應為 list、get 或 set",
+	"missing setting name":                                        "缺少設定名稱",
+	"usage: config set <key> <value>":                             "用法：config set <key> <value>",
+	"unknown subcommand %q - expected list, get or set":           "未知子命令 %q - 應為 list、get 或 set",
+	" or ":            " 或 ",
+	"%v, and %d more": "%v，另外還有 %d 個",
+	"Unknown command %q. Run '%s help' for a list of commands.":     "未知命令 %q。執行 '%s help' 查看命令列表。",
+	"%d matches - explore an area by name or id":                    "%d 個結果 - 按名稱或 ID 探索區域",
+	"1 match - explore an area by name or id":                       "1 個結果 - 按名稱或 ID 探索區域",
+	"%d more not shown - narrow the search or raise page_size":      "還有 %d 個未顯示 - 請縮小搜尋範圍或增大 page_size",
+	"No location areas match %q.":                                   "沒有與 %q 相符的區域。",
+	"%s Your %s evolved into %s!":                                   "%s 你的%s進化成了%s！",
+	"What? %s is evolving!":                                         "咦？%s的樣子……！",
+	"Congratulations!":                                              "恭喜！",
+	"Evolutions of %s:":                                             "%s的進化：",
+	"%s can be found in:":                                           "%s可以在以下地點找到：",
+	"%s can't be found in the wild in %s.":                          "%s在%s中無法在野外找到。",
+	"%s can't be found in the wild.":                                "%s無法在野外找到。",
+	"%s can evolve into %s - choose one with 'evolve %s <pokemon>'": "%s可以進化成%s - 請用 'evolve %s <pokemon>' 選擇一個",
+	"%s can't evolve yet: %s":                                       "%s還不能進化：%s",
+	"%s does not evolve any further":                                "%s不會再進化了",
+	"%s does not evolve into %s":                                    "%s不會進化成%s",
+	"%s has no evolutions":                                          "%s沒有進化",
+	"%s has no such sprite":                                         "%s沒有這種圖像",
+	"%s has no such sprite in generation %s":                        "%s在第 %s 世代沒有這種圖像",
+	"%s moves:":                                                     "%s屬性招式：",
+	"%s type":                                                       "%s屬性",
+	"%s vs %s:":                                                     "%s 對 %s：",
+	"(%d version groups)":                                           "(%d 個版本組)",
+	"(you are here)":                                                "(你在這裡)",
+	"Accuracy: %s":                                                  "命中：%s",
+	"Category: %s":                                                  "分類：%s",
+	"Effect: %s":                                                    "效果：%s",
+	"PP: %d":                                                        "PP：%d",
+	"Power: %s":                                                     "威力：%s",
+	"Priority: %+d":                                                 "優先度：%+d",
+	"Type: %s":                                                      "屬性：%s",
+	"Introduced in: %s":                                             "首次登場：%s",
+	"Pokemon with this ability:":                                    "擁有該特性的寶可夢：",
+	"Bag:":                                                          "背包：",
+	"Location: %s":                                                  "地點：%s",
+	"Region: %s":                                                    "地區：%s",
+	"Regions:":                                                      "地區：",
+	"Locations in %s:":                                              "%s的地點：",
+	"Areas in %s:":                                                  "%s的區域：",
+	"No regions found.":                                             "沒有找到地區。",
+	"No locations found.":                                           "沒有找到地點。",
+	"No areas found.":                                               "沒有找到區域。",
+	"Travelled to %s!":                                              "來到了%s！",
+	"You are in %s.":                                                "你在%s。",
+	"You haven't travelled anywhere yet. Use 'goto <area>' to set out.": "你還沒有去過任何地方。用 'goto <area>' 出發吧。",
+	"Matchups of %s:":                     "%s的屬性相剋：",
+	"Weak to:":                            "弱點：",
+	"Resists:":                            "抵抗：",
+	"Immune to:":                          "免疫：",
+	"no effect":                           "沒有效果",
+	"normal damage":                       "普通傷害",
+	"not very effective":                  "效果不好",
+	"super effective":                     "效果絕佳",
+	"Moves of %s in %s:":                  "%s的招式（%s）：",
+	"Moves of %s:":                        "%s的招式：",
+	"No moves found.":                     "沒有找到招式。",
+	"Random seed: %d":                     "隨機種子：%d",
+	"Usage:":                              "用法：",
+	"Welcome to the Pokedex!":             "歡迎使用寶可夢圖鑑！",
+	"lv %d":                               "Lv.%d",
+	"lv %d-%d":                            "Lv.%d-%d",
+	"level %d":                            "等級 %d",
+	"level up":                            "升級",
+	"use %s":                              "使用%s",
+	"trade":                               "連接交換",
+	"happiness %d+":                       "親密度 %d+",
+	"affection %d+":                       "友好度 %d+",
+	"beauty %d+":                          "美麗 %d+",
+	"holding %s":                          "攜帶%s",
+	"knowing %s":                          "學會%s",
+	"knowing a %s move":                   "學會%s屬性招式",
+	"at %s":                               "在%s",
+	"for %s":                              "與%s",
+	"with %s in the party":                "隊伍中有%s",
+	"with a %s type in the party":         "隊伍中有%s屬性寶可夢",
+	"female":                              "雌性",
+	"male":                                "雄性",
+	"attack > defense":                    "攻擊 > 防禦",
+	"attack < defense":                    "攻擊 < 防禦",
+	"in the rain":                         "下雨時",
+	"upside down":                         "倒置主機",
+	"day":                                 "白天",
+	"dusk":                                "黃昏",
+	"night":                               "夜晚",
+	"needs %s, which your bag can't hold": "需要%s，但你的背包裝不下",
+	"needs happiness %d (it has %d)":      "需要親密度 %d（目前為 %d）",
+	"needs level %d (it is level %d)":     "需要等級 %d（目前為 %d 級）",
+	"needs level %d, but only pokemon caught in game mode have a level": "需要等級 %d，但只有在遊戲模式中捕捉的寶可夢才有等級",
+	"needs to %s": "需要：%s",
+	"needs to %s, which the Pokedex can't check":                        "需要：%s，圖鑑無法檢查",
+	"needs to be traded":                                                "需要連接交換",
+	"needs to know %s":                                                  "需要學會%s",
+	"needs to level up at %s":                                           "需要在%s升級",
+	"could not add %s to pokedex - %w":                                  "無法將%s加入圖鑑 - %w",
+	"could not download sprite - %w":                                    "無法下載圖像 - %w",
+	"could not encode result as json - %w":                              "無法將結果編碼為 JSON - %w",
+	"could not explore area - %w":                                       "無法探索區域 - %w",
+	"could not fetch location area index - %w":                          "無法取得區域索引 - %w",
+	"could not find ability - %w":                                       "找不到特性 - %w",
+	"could not find encounters of %s - %w":                              "找不到%s的出現地點 - %w",
+	"could not find form %s - %w":                                       "找不到形態 %s - %w",
+	"could not find move - %w":                                          "找不到招式 - %w",
+	"could not find pokemon - %w":                                       "找不到寶可夢 - %w",
+	"could not list areas - %w":                                         "無法列出區域 - %w",
+	"could not list locations - %w":                                     "無法列出地點 - %w",
+	"could not list regions - %w":                                       "無法列出地區 - %w",
+	"could not look up %s - %w":                                         "無法查詢%s - %w",
+	"could not look up evolutions of %s - %w":                           "無法查詢%s的進化 - %w",
+	"could not look up form %s - %w":                                    "無法查詢形態 %s - %w",
+	"could not look up species of %s - %w":                              "無法查詢%s的種類 - %w",
+	"could not look up the moves of %s - %w":                            "無法查詢%s的招式 - %w",
+	"could not look up type %s - %w":                                    "無法查詢屬性 %s - %w",
+	"could not travel to %s - %w":                                       "無法前往%s - %w",
+	"could not walk through %s - %w":                                    "無法在%s中行走 - %w",
+	"forms only have sprites from the latest games":                     "形態只有最新遊戲的圖像",
+	"invalid seed %q - expected a whole number":                         "無效的種子 %q - 應為整數",
+	"missing ability":                                                   "缺少特性",
+	"missing area - use 'areas <location>' to find one":                 "缺少區域 - 用 'areas <location>' 查找",
+	"missing location - use 'locations <region>' to list them":          "缺少地點 - 用 'locations <region>' 列出",
+	"missing move":                                                      "缺少招式",
+	"missing pokemon":                                                   "缺少寶可夢",
+	"missing region - use 'regions' to list them":                       "缺少地區 - 用 'regions' 列出",
+	"missing search term":                                               "缺少搜尋詞",
+	"there is no pokemon or type called %s":                             "沒有名為 %s 的寶可夢或屬性",
+	"unknown argument %q - expected restock":                            "未知參數 %q - 應為 restock",
+	"unknown generation %q - expected one of %s":                        "未知世代 %q - 應為 %s 之一",
+	"usage of %s:\n%s":                                                  "%s 的用法：\n%s",
+	"usage: matchup <pokemon|type> or matchup <attacker> vs <defender>": "用法：matchup <pokemon|type> 或 matchup <attacker> vs <defender>",
+	"%s cries out!":                                                     "%s發出了叫聲！",
+	"Saved the cry of %s to %s":                                         "已將%s的叫聲儲存到 %s",
+	"%s has no cry":                                                     "%s沒有叫聲",
+	"%s has no legacy cry":                                              "%s沒有初代叫聲",
+	"could not download cry - %w":                                       "無法下載叫聲 - %w",
+	"could not save cry - %w":                                           "無法儲存叫聲 - %w",
+	"invalid cry_player - %w":                                           "無效的 cry_player - %w",
+	"no player for cries - set cry_player, e.g. 'config set cry_player ffplay -nodisp -autoexit', or use --save <file>": "沒有叫聲播放器 - 請設定 cry_player，例如 'config set cry_player ffplay -nodisp -autoexit'，或使用 --save <file>",
+}
@@ -0,0 +1,119 @@
+package i18n
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Default is the language the CLI's own messages are written in.
+const Default = "en"
+
+// Languages are the PokeAPI language codes names and messages can be shown
+// in.
+var Languages = []string{"en", "de", "fr", "es", "it", "ja", "ja-Hrkt", "ko", "zh-Hans", "zh-Hant"}
+
+// catalog translates the CLI's own messages, keyed by the English format
+// string. Each language has a file of its own.
+var catalog = map[string]map[string]string{
+	"de":      de,
+	"fr":      fr,
+	"es":      es,
+	"it":      it,
+	"ja":      ja,
+	"ko":      ko,
+	"zh-Hans": zhHans,
+	"zh-Hant": zhHant,
+}
+
+// aliases are languages whose messages are those of another language. Kana
+// names are shown alongside the Japanese messages.
+var aliases = map[string]string{
+	"ja-Hrkt": "ja",
+}
+
+// Valid reports whether lang is one of Languages.
+func Valid(lang string) bool {
+	return slices.Contains(Languages, lang)
+}
+
+// messages returns the catalog of lang, nil for English.
+func messages(lang string) map[string]string {
+	if alias, ok := aliases[lang]; ok {
+		lang = alias
+	}
+	return catalog[lang]
+}
+
+// Printer formats the CLI's messages in one language. The zero value prints
+// English.
+type Printer struct {
+	lang string
+}
+
+func New(lang string) Printer {
+	return Printer{lang: lang}
+}
+
+// Lang returns the language of the printer.
+func (p Printer) Lang() string {
+	if p.lang == "" {
+		return Default
+	}
+	return p.lang
+}
+
+// Sprintf formats the translation of the English format string.
+func (p Printer) Sprintf(format string, args ...any) string {
+	if translated, ok := messages(p.lang)[format]; ok {
+		format = translated
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// Error returns the message of err, translated along with the errors it
+// wraps if err was made by Errorf. Errors wrapping one are shown in English.
+func (p Printer) Error(err error) string {
+	e, ok := err.(*Error)
+	if !ok {
+		return err.Error()
+	}
+	args := make([]any, len(e.args))
+	for i, arg := range e.args {
+		if wrapped, ok := arg.(error); ok {
+			arg = p.Error(wrapped)
+		}
+		args[i] = arg
+	}
+	if translated, ok := messages(p.lang)[e.format]; ok {
+		return fmt.Sprintf(strings.ReplaceAll(translated, "%w", "%v"), args...)
+	}
+	return fmt.Sprintf(strings.ReplaceAll(e.format, "%w", "%v"), args...)
+}
+
+// Error is an error whose message can be translated.
+type Error struct {
+	format string
+	args   []any
+}
+
+// Errorf returns an error with an English message that a Printer can
+// translate. Like fmt.Errorf, a %w verb wraps an error.
+func Errorf(format string, args ...any) error {
+	return &Error{format: format, args: args}
+}
+
+func (e *Error) Error() string {
+	return fmt.Errorf(e.format, e.args...).Error()
+}
+
+// Unwrap returns the errors wrapped with %w.
+func (e *Error) Unwrap() []error {
+	switch err := fmt.Errorf(e.format, e.args...).(type) {
+	case interface{ Unwrap() error }:
+		return []error{err.Unwrap()}
+	case interface{ Unwrap() []error }:
+		return err.Unwrap()
+	}
+	return nil
+}
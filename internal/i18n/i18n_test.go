@@ -0,0 +1,88 @@
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+func TestSprintf(t *testing.T) {
+	cases := []struct {
+		lang     string
+		format   string
+		args     []any
+		expected string
+	}{
+		{lang: "en", format: "page %d of %d", args: []any{1, 3}, expected: "page 1 of 3"},
+		{lang: "de", format: "page %d of %d", args: []any{1, 3}, expected: "Seite 1 von 3"},
+		{lang: "ja", format: "page %d of %d", args: []any{1, 3}, expected: "1 / 3 ページ"},
+		{lang: "ja-Hrkt", format: "Stats:", expected: "能力:"},
+		{lang: "fr", format: "not in the catalog", expected: "not in the catalog"},
+		{lang: "", format: "Stats:", expected: "Stats:"},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			if got := New(c.lang).Sprintf(c.format, c.args...); got != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, got)
+			}
+		})
+	}
+}
+
+func TestError(t *testing.T) {
+	err := Errorf("you have not caught %s", "pikachu")
+	cases := []struct {
+		lang     string
+		err      error
+		expected string
+	}{
+		{lang: "en", err: err, expected: "you have not caught pikachu"},
+		{lang: "de", err: err, expected: "du hast pikachu nicht gefangen"},
+		{lang: "de", err: fmt.Errorf("could not fight - %w", err), expected: "could not fight - you have not caught pikachu"},
+		{lang: "de", err: errors.New("missing pokemon"), expected: "missing pokemon"},
+		{lang: "en", err: Errorf("could not find pokemon - %w", err), expected: "could not find pokemon - you have not caught pikachu"},
+		{lang: "de", err: Errorf("could not find pokemon - %w", err), expected: "Pokemon nicht gefunden - du hast pikachu nicht gefangen"},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			if got := New(c.lang).Error(c.err); got != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, got)
+			}
+		})
+	}
+
+	notFound := errors.New("not found")
+	if wrapped := Errorf("could not find pokemon - %w", notFound); !errors.Is(wrapped, notFound) || wrapped.Error() != "could not find pokemon - not found" {
+		t.Errorf("expected the error to wrap %v, got %v", notFound, wrapped)
+	}
+}
+
+var verb = regexp.MustCompile(`%[-+# 0-9.]*[a-zA-Z%]`)
+
+// TestCatalog checks that every translation takes the same arguments as
+// the English message and that every language translates every message.
+func TestCatalog(t *testing.T) {
+	for _, lang := range Languages {
+		if lang != Default && messages(lang) == nil {
+			t.Errorf("expected messages for %s", lang)
+		}
+	}
+
+	keys := catalog["de"]
+	for lang, messages := range catalog {
+		if !Valid(lang) {
+			t.Errorf("catalog has unknown language %q", lang)
+		}
+		if len(messages) != len(keys) {
+			t.Errorf("expected %d messages in %s, got %d", len(keys), lang, len(messages))
+		}
+		for format, translated := range messages {
+			if got, want := verb.FindAllString(translated, -1), verb.FindAllString(format, -1); fmt.Sprint(got) != fmt.Sprint(want) {
+				t.Errorf("expected %s translation of %q to use %v, got %v", lang, format, want, got)
+			}
+		}
+	}
+}
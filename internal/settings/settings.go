@@ -0,0 +1,387 @@
+package settings
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/acehotel33/pokedex-cli/internal/animation"
+	"github.com/acehotel33/pokedex-cli/internal/i18n"
+	"github.com/acehotel33/pokedex-cli/internal/sprite"
+	"github.com/acehotel33/pokedex-cli/internal/theme"
+)
+
+// EnvPrefix is prepended to the upper-cased key of a setting to form the
+// environment variable overriding it, e.g. POKEDEX_PAGE_SIZE.
+const EnvPrefix = "POKEDEX_"
+
+type Settings struct {
+	APIURL         string
+	CacheTTL       time.Duration
+	AnimationDelay time.Duration
+	Animation      string
+	PageSize       int
+	SaveFile       string
+	Color          string
+	Theme          string
+	Version        string
+	Mode           string
+	Lang           string
+	Sprite         string
+	ShinyOdds      int
+	CryPlayer      string
+}
+
+func Default() Settings {
+	return Settings{
+		APIURL:         "https://pokeapi.co/api/v2/",
+		CacheTTL:       5 * time.Minute,
+		AnimationDelay: time.Second,
+		Animation:      animation.Normal,
+		PageSize:       20,
+		SaveFile:       filepath.Join(configDir(), "pokedex.json"),
+		Color:          "auto",
+		Theme:          "default",
+		Version:        "all",
+		Mode:           "lookup",
+		Lang:           i18n.Default,
+		Sprite:         sprite.Off,
+		ShinyOdds:      4096,
+	}
+}
+
+// DefaultPath is where the config file is read from unless overridden.
+func DefaultPath() string {
+	return filepath.Join(configDir(), "config.json")
+}
+
+func configDir() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "."
+	}
+	return filepath.Join(dir, "pokedex-cli")
+}
+
+type field struct {
+	key         string
+	description string
+	get         func(s *Settings) string
+	set         func(s *Settings, val string) error
+}
+
+var fields = []field{
+	{
+		key:         "api_url",
+		description: "base URL of the PokeAPI",
+		get:         func(s *Settings) string { return s.APIURL },
+		set: func(s *Settings, val string) error {
+			if !strings.HasPrefix(val, "http://") && !strings.HasPrefix(val, "https://") {
+				return fmt.Errorf("api_url must start with http:// or https://")
+			}
+			if !strings.HasSuffix(val, "/") {
+				val += "/"
+			}
+			s.APIURL = val
+			return nil
+		},
+	},
+	{
+		key:         "cache_ttl",
+		description: "how long API responses are cached, e.g. 5m",
+		get:         func(s *Settings) string { return s.CacheTTL.String() },
+		set: func(s *Settings, val string) error {
+			return setDuration(&s.CacheTTL, val)
+		},
+	},
+	{
+		key:         "animation_delay",
+		description: "pause between the steps of the catch animation, e.g. 1s",
+		get:         func(s *Settings) string { return s.AnimationDelay.String() },
+		set: func(s *Settings, val string) error {
+			return setDuration(&s.AnimationDelay, val)
+		},
+	},
+	{
+		key:         "animation",
+		description: "speed of the catch animation: " + strings.Join(animation.Speeds(), ", ") + "; it is always off when not on a terminal",
+		get:         func(s *Settings) string { return s.Animation },
+		set: func(s *Settings, val string) error {
+			if !animation.Valid(val) {
+				return fmt.Errorf("animation must be one of %s", strings.Join(animation.Speeds(), ", "))
+			}
+			s.Animation = val
+			return nil
+		},
+	},
+	{
+		key:         "page_size",
+		description: "number of locations shown per map page",
+		get:         func(s *Settings) string { return strconv.Itoa(s.PageSize) },
+		set: func(s *Settings, val string) error {
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 {
+				return fmt.Errorf("page_size must be a positive number")
+			}
+			s.PageSize = n
+			return nil
+		},
+	},
+	{
+		key:         "shiny_odds",
+		description: "chance of a caught pokemon being shiny, as one in this many",
+		get:         func(s *Settings) string { return strconv.Itoa(s.ShinyOdds) },
+		set: func(s *Settings, val string) error {
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 {
+				return fmt.Errorf("shiny_odds must be a positive number")
+			}
+			s.ShinyOdds = n
+			return nil
+		},
+	},
+	{
+		key:         "save_file",
+		description: "file the caught pokemon are saved to",
+		get:         func(s *Settings) string { return s.SaveFile },
+		set: func(s *Settings, val string) error {
+			if val == "" {
+				return fmt.Errorf("save_file must not be empty")
+			}
+			s.SaveFile = val
+			return nil
+		},
+	},
+	{
+		key:         "color",
+		description: "auto, always or never; auto honours NO_COLOR and disables colour when not on a terminal",
+		get:         func(s *Settings) string { return s.Color },
+		set: func(s *Settings, val string) error {
+			switch val {
+			case "true":
+				val = "always"
+			case "false":
+				val = "never"
+			}
+			if val != "auto" && val != "always" && val != "never" {
+				return fmt.Errorf("color must be auto, always or never")
+			}
+			s.Color = val
+			return nil
+		},
+	},
+	{
+		key:         "theme",
+		description: "colour theme: " + strings.Join(theme.Names(), ", "),
+		get:         func(s *Settings) string { return s.Theme },
+		set: func(s *Settings, val string) error {
+			if _, err := theme.New(val, false); err != nil {
+				return err
+			}
+			s.Theme = val
+			return nil
+		},
+	},
+	{
+		key:         "version",
+		description: "game version encounters are shown for, e.g. red, or all",
+		get:         func(s *Settings) string { return s.Version },
+		set: func(s *Settings, val string) error {
+			if val == "" {
+				return fmt.Errorf("version must not be empty - use all for every version")
+			}
+			s.Version = strings.ToLower(val)
+			return nil
+		},
+	},
+	{
+		key:         "mode",
+		description: "lookup to catch any pokemon by name, game to only catch the wild pokemon met with walk",
+		get:         func(s *Settings) string { return s.Mode },
+		set: func(s *Settings, val string) error {
+			if val != "lookup" && val != "game" {
+				return fmt.Errorf("mode must be lookup or game")
+			}
+			s.Mode = val
+			return nil
+		},
+	},
+	{
+		key:         "lang",
+		description: "language of pokemon and place names and of messages, one of " + strings.Join(i18n.Languages, ", "),
+		get:         func(s *Settings) string { return s.Lang },
+		set: func(s *Settings, val string) error {
+			if !i18n.Valid(val) {
+				return i18n.Errorf("lang must be one of %s", strings.Join(i18n.Languages, ", "))
+			}
+			s.Lang = val
+			return nil
+		},
+	},
+	{
+		key:         "cry_player",
+		description: "command playing pokemon cries, given the path of an ogg file, e.g. ffplay -nodisp -autoexit, quoting arguments with spaces; empty for none",
+		get:         func(s *Settings) string { return s.CryPlayer },
+		set: func(s *Settings, val string) error {
+			s.CryPlayer = strings.TrimSpace(val)
+			return nil
+		},
+	},
+	{
+		key:         "sprite",
+		description: "how inspect draws the pokemon's sprite: " + strings.Join(sprite.Modes(), ", ") + "; off only draws it with inspect --sprite",
+		get:         func(s *Settings) string { return s.Sprite },
+		set: func(s *Settings, val string) error {
+			if !sprite.Valid(val) {
+				return fmt.Errorf("sprite must be one of %s", strings.Join(sprite.Modes(), ", "))
+			}
+			s.Sprite = val
+			return nil
+		},
+	},
+}
+
+func setDuration(d *time.Duration, val string) error {
+	parsed, err := time.ParseDuration(val)
+	if err != nil || parsed < 0 {
+		return fmt.Errorf("invalid duration %q", val)
+	}
+	*d = parsed
+	return nil
+}
+
+func lookup(key string) (field, error) {
+	for _, f := range fields {
+		if f.key == key {
+			return f, nil
+		}
+	}
+	return field{}, fmt.Errorf("unknown setting %q", key)
+}
+
+// Keys lists every setting in display order.
+func Keys() []string {
+	keys := make([]string, 0, len(fields))
+	for _, f := range fields {
+		keys = append(keys, f.key)
+	}
+	return keys
+}
+
+func Describe(key string) string {
+	f, err := lookup(key)
+	if err != nil {
+		return ""
+	}
+	return f.description
+}
+
+func (s *Settings) Get(key string) (string, error) {
+	f, err := lookup(key)
+	if err != nil {
+		return "", err
+	}
+	return f.get(s), nil
+}
+
+func (s *Settings) Set(key, val string) error {
+	f, err := lookup(key)
+	if err != nil {
+		return err
+	}
+	return f.set(s, val)
+}
+
+// EnvVar returns the environment variable that overrides key.
+func EnvVar(key string) string {
+	return EnvPrefix + strings.ToUpper(key)
+}
+
+// Load returns the defaults overridden first by the config file at path,
+// which may be missing, and then by POKEDEX_* environment variables.
+func Load(path string) (Settings, error) {
+	s, err := LoadFile(path)
+	if err != nil {
+		return Settings{}, err
+	}
+
+	for _, key := range Keys() {
+		if val, ok := os.LookupEnv(EnvVar(key)); ok {
+			if err := s.Set(key, val); err != nil {
+				return Settings{}, fmt.Errorf("invalid %s - %w", EnvVar(key), err)
+			}
+		}
+	}
+	return s, nil
+}
+
+// LoadFile returns the defaults overridden by the config file at path only.
+func LoadFile(path string) (Settings, error) {
+	s := Default()
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return Settings{}, fmt.Errorf("could not read config file - %w", err)
+	}
+
+	// Values may be written as JSON numbers and booleans, e.g. "page_size": 50,
+	// as well as the strings Save writes.
+	values := map[string]any{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&values); err != nil {
+		return Settings{}, fmt.Errorf("could not decode config file - %w", err)
+	}
+	for key, raw := range values {
+		val, err := formatValue(raw)
+		if err == nil {
+			err = s.Set(key, val)
+		}
+		if err != nil {
+			return Settings{}, fmt.Errorf("invalid config file %s - %s: %w", path, key, err)
+		}
+	}
+	return s, nil
+}
+
+// formatValue returns a value decoded from JSON as the text Set takes.
+func formatValue(raw any) (string, error) {
+	switch val := raw.(type) {
+	case string:
+		return val, nil
+	case json.Number:
+		return val.String(), nil
+	case bool:
+		return strconv.FormatBool(val), nil
+	}
+	return "", fmt.Errorf("expected a string, number or boolean")
+}
+
+// Save writes every setting to the config file at path.
+func Save(path string, s Settings) error {
+	values := map[string]string{}
+	for _, f := range fields {
+		values[f.key] = f.get(&s)
+	}
+
+	data, err := json.MarshalIndent(values, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode config - %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("could not create config directory - %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("could not write config file - %w", err)
+	}
+	return nil
+}
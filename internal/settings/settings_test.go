@@ -0,0 +1,132 @@
+package settings
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadPrecedence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+
+	s := Default()
+	if err := s.Set("page_size", "50"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if err := s.Set("cache_ttl", "1m"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if err := Save(path, s); err != nil {
+		t.Errorf("unexpected error saving: %v", err)
+		return
+	}
+
+	t.Setenv("POKEDEX_CACHE_TTL", "30s")
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Errorf("unexpected error loading: %v", err)
+		return
+	}
+	if loaded.PageSize != 50 {
+		t.Errorf("expected page_size from file, got %d", loaded.PageSize)
+	}
+	if loaded.CacheTTL != 30*time.Second {
+		t.Errorf("expected cache_ttl from environment, got %v", loaded.CacheTTL)
+	}
+	if loaded.APIURL != Default().APIURL {
+		t.Errorf("expected default api_url, got %s", loaded.APIURL)
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	cases := []struct {
+		contents string
+		expected func(s Settings) bool
+		valid    bool
+	}{
+		{
+			contents: `{"page_size": "50", "color": "never"}`,
+			expected: func(s Settings) bool { return s.PageSize == 50 && s.Color == "never" },
+			valid:    true,
+		},
+		{
+			contents: `{"page_size": 50, "color": false, "cache_ttl": "1m"}`,
+			expected: func(s Settings) bool { return s.PageSize == 50 && s.Color == "never" && s.CacheTTL == time.Minute },
+			valid:    true,
+		},
+		{
+			contents: `{"shiny_odds": 1}`,
+			expected: func(s Settings) bool { return s.ShinyOdds == 1 },
+			valid:    true,
+		},
+		{contents: `{"page_size": 2.5}`, valid: false},
+		{contents: `{"page_size": null}`, valid: false},
+		{contents: `{"lang": ["de"]}`, valid: false},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.json")
+			if err := os.WriteFile(path, []byte(c.contents), 0o644); err != nil {
+				t.Errorf("unexpected error writing: %v", err)
+				return
+			}
+			s, err := LoadFile(path)
+			if !c.valid {
+				if err == nil {
+					t.Errorf("expected %s to be rejected", c.contents)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error loading %s: %v", c.contents, err)
+				return
+			}
+			if !c.expected(s) {
+				t.Errorf("unexpected settings from %s: %+v", c.contents, s)
+			}
+		})
+	}
+}
+
+func TestSet(t *testing.T) {
+	cases := []struct {
+		key   string
+		val   string
+		valid bool
+	}{
+		{key: "api_url", val: "http://localhost:8080/api", valid: true},
+		{key: "api_url", val: "pokeapi.co", valid: false},
+		{key: "cache_ttl", val: "soon", valid: false},
+		{key: "animation_delay", val: "0s", valid: true},
+		{key: "animation", val: "slow", valid: true},
+		{key: "animation", val: "fast", valid: false},
+		{key: "lang", val: "ja-Hrkt", valid: true},
+		{key: "lang", val: "klingon", valid: false},
+		{key: "sprite", val: "256", valid: true},
+		{key: "sprite", val: "sixel", valid: false},
+		{key: "page_size", val: "0", valid: false},
+		{key: "shiny_odds", val: "1", valid: true},
+		{key: "shiny_odds", val: "0", valid: false},
+		{key: "color", val: "false", valid: true},
+		{key: "colour", val: "red", valid: false},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			s := Default()
+			err := s.Set(c.key, c.val)
+			if c.valid && err != nil {
+				t.Errorf("expected %s=%s to be accepted, got %v", c.key, c.val, err)
+			}
+			if !c.valid && err == nil {
+				t.Errorf("expected %s=%s to be rejected", c.key, c.val)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package sprite
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"strings"
+)
+
+// Modes sprites are drawn in. Auto picks one of the others for the
+// terminal.
+const (
+	Off       = "off"
+	Auto      = "auto"
+	TrueColor = "truecolor"
+	Color256  = "256"
+	ASCII     = "ascii"
+)
+
+// asciiRamp runs from the lightest to the darkest character.
+const asciiRamp = ".:-=+*#%@"
+
+// Modes lists the valid mode settings.
+func Modes() []string {
+	return []string{Off, Auto, TrueColor, Color256, ASCII}
+}
+
+// Valid reports whether mode is one of Modes.
+func Valid(mode string) bool {
+	for _, m := range Modes() {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
+// Detect resolves the auto mode: ASCII art without colour, truecolor when
+// COLORTERM says the terminal supports it and the 256 colour palette
+// otherwise. Other modes are returned as they are.
+func Detect(mode string, color bool) string {
+	if mode != Auto {
+		return mode
+	}
+	if !color {
+		return ASCII
+	}
+	switch os.Getenv("COLORTERM") {
+	case "truecolor", "24bit":
+		return TrueColor
+	}
+	return Color256
+}
+
+// Decode decodes a PNG sprite.
+func Decode(data []byte) (image.Image, error) {
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("could not decode sprite - %w", err)
+	}
+	return img, nil
+}
+
+// Render draws img in mode as lines of text at most width columns wide,
+// cropped to its visible pixels. Each line covers two rows of pixels: in
+// colour the upper one is the foreground of a half block and the lower one
+// its background, in ASCII art their brightness picks a character.
+func Render(img image.Image, mode string, width int) []string {
+	bounds := crop(img)
+	if bounds.Empty() {
+		return nil
+	}
+	step := 1
+	if width > 0 {
+		step = (bounds.Dx() + width - 1) / width
+	}
+
+	// pixel samples the image scaled down by step, nearest neighbour.
+	pixel := func(x, y int) (color.NRGBA, bool) {
+		px, py := bounds.Min.X+x*step, bounds.Min.Y+y*step
+		if py >= bounds.Max.Y {
+			return color.NRGBA{}, false
+		}
+		c := color.NRGBAModel.Convert(img.At(px, py)).(color.NRGBA)
+		return c, c.A >= 128
+	}
+
+	cols := (bounds.Dx() + step - 1) / step
+	rows := (bounds.Dy() + step - 1) / step
+	lines := []string{}
+	for y := 0; y < rows; y += 2 {
+		var line strings.Builder
+		for x := 0; x < cols; x++ {
+			top, topOK := pixel(x, y)
+			bottom, bottomOK := pixel(x, y+1)
+			line.WriteString(cell(mode, top, topOK, bottom, bottomOK))
+		}
+		text := line.String()
+		if mode == ASCII {
+			text = strings.TrimRight(text, " ")
+		}
+		lines = append(lines, text)
+	}
+	return lines
+}
+
+// cell draws one character from the pixel above and the pixel below it,
+// each only if it is visible.
+func cell(mode string, top color.NRGBA, topOK bool, bottom color.NRGBA, bottomOK bool) string {
+	if mode == ASCII {
+		switch {
+		case topOK && bottomOK:
+			return shade((brightness(top) + brightness(bottom)) / 2)
+		case topOK:
+			return shade(brightness(top))
+		case bottomOK:
+			return shade(brightness(bottom))
+		}
+		return " "
+	}
+
+	switch {
+	case topOK && bottomOK:
+		return "\033[" + colorCode(mode, 38, top) + ";" + colorCode(mode, 48, bottom) + "m▀\033[0m"
+	case topOK:
+		return "\033[" + colorCode(mode, 38, top) + "m▀\033[0m"
+	case bottomOK:
+		return "\033[" + colorCode(mode, 38, bottom) + "m▄\033[0m"
+	}
+	return " "
+}
+
+// colorCode returns the SGR parameters setting the foreground (layer 38) or
+// background (layer 48) to c.
+func colorCode(mode string, layer int, c color.NRGBA) string {
+	if mode == TrueColor {
+		return fmt.Sprintf("%d;2;%d;%d;%d", layer, c.R, c.G, c.B)
+	}
+	return fmt.Sprintf("%d;5;%d", layer, xterm256(c))
+}
+
+// xterm256 returns the closest colour of the 6x6x6 cube or the grey ramp of
+// the 256 colour palette.
+func xterm256(c color.NRGBA) int {
+	level := func(v uint8) int {
+		if v < 48 {
+			return 0
+		}
+		if v < 115 {
+			return 1
+		}
+		return (int(v) - 35) / 40
+	}
+	r, g, b := level(c.R), level(c.G), level(c.B)
+	if r == g && g == b {
+		grey := (int(c.R) + int(c.G) + int(c.B)) / 3
+		if grey > 8 && grey < 238 {
+			return 232 + (grey-8)/10
+		}
+	}
+	return 16 + 36*r + 6*g + b
+}
+
+// brightness returns the perceived brightness of c from 0 to 255.
+func brightness(c color.NRGBA) int {
+	return (299*int(c.R) + 587*int(c.G) + 114*int(c.B)) / 1000
+}
+
+// shade picks the ASCII character for a brightness, darker colours being
+// drawn with denser characters.
+func shade(b int) string {
+	i := (255 - b) * len(asciiRamp) / 256
+	return asciiRamp[i : i+1]
+}
+
+// crop returns the smallest rectangle holding every visible pixel of img.
+func crop(img image.Image) image.Rectangle {
+	var visible image.Rectangle
+	b := img.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if _, _, _, a := img.At(x, y).RGBA(); a < 0x8000 {
+				continue
+			}
+			visible = visible.Union(image.Rect(x, y, x+1, y+1))
+		}
+	}
+	return visible
+}
@@ -0,0 +1,93 @@
+package sprite
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"reflect"
+	"testing"
+)
+
+// testImage is a 4x4 sprite with a transparent border around a red pixel
+// above a white one.
+func testImage() image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	img.Set(1, 1, color.NRGBA{R: 255, A: 255})
+	img.Set(1, 2, color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+	img.Set(2, 2, color.NRGBA{A: 255})
+	return img
+}
+
+func TestRender(t *testing.T) {
+	cases := []struct {
+		mode     string
+		width    int
+		expected []string
+	}{
+		{
+			mode:     TrueColor,
+			expected: []string{"\033[38;2;255;0;0;48;2;255;255;255m▀\033[0m\033[38;2;0;0;0m▄\033[0m"},
+		},
+		{
+			mode:     Color256,
+			expected: []string{"\033[38;5;196;48;5;231m▀\033[0m\033[38;5;16m▄\033[0m"},
+		},
+		{
+			mode:     ASCII,
+			expected: []string{"=@"},
+		},
+		{
+			mode:     ASCII,
+			width:    1,
+			expected: []string{"#"},
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			lines := Render(testImage(), c.mode, c.width)
+			if !reflect.DeepEqual(lines, c.expected) {
+				t.Errorf("expected %q, got %q", c.expected, lines)
+				return
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, testImage()); err != nil {
+		t.Fatalf("could not encode test image: %v", err)
+	}
+	img, err := Decode(buf.Bytes())
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if img.Bounds().Dx() != 4 {
+		t.Errorf("expected a 4 pixel wide image, got %v", img.Bounds())
+	}
+
+	if _, err := Decode([]byte("not a png")); err == nil {
+		t.Errorf("expected an error decoding garbage")
+	}
+}
+
+func TestDetect(t *testing.T) {
+	t.Setenv("COLORTERM", "truecolor")
+	if mode := Detect(Auto, true); mode != TrueColor {
+		t.Errorf("expected truecolor, got %s", mode)
+	}
+	if mode := Detect(Auto, false); mode != ASCII {
+		t.Errorf("expected ascii without colour, got %s", mode)
+	}
+	t.Setenv("COLORTERM", "")
+	if mode := Detect(Auto, true); mode != Color256 {
+		t.Errorf("expected 256 colours, got %s", mode)
+	}
+	if mode := Detect(ASCII, true); mode != ASCII {
+		t.Errorf("expected an explicit mode to be kept, got %s", mode)
+	}
+}
@@ -0,0 +1,74 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/acehotel33/pokedex-cli/globals"
+)
+
+// SaveData is the part of a session that is kept between runs.
+type SaveData struct {
+	Pokedex   map[string]globals.Pokemon `json:"pokedex"`
+	Position  globals.Position           `json:"position"`
+	Encounter *globals.WildEncounter     `json:"encounter,omitempty"`
+	// Inventory is nil for saves from before the trainer had one.
+	Inventory map[string]int `json:"inventory"`
+	Lead      string         `json:"lead,omitempty"`
+}
+
+// Load reads the save file written by Save. A missing file is not an error
+// and yields an empty save.
+func Load(path string) (SaveData, error) {
+	save := SaveData{Pokedex: make(map[string]globals.Pokemon)}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return save, nil
+	}
+	if err != nil {
+		return SaveData{}, fmt.Errorf("could not read save file - %w", err)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return SaveData{}, fmt.Errorf("could not decode save file - %w", err)
+	}
+	// Older save files held only the pokedex.
+	target := any(&save)
+	if _, ok := fields["pokedex"]; !ok {
+		target = &save.Pokedex
+	}
+	if err := json.Unmarshal(data, target); err != nil {
+		return SaveData{}, fmt.Errorf("could not decode save file - %w", err)
+	}
+	if save.Pokedex == nil {
+		save.Pokedex = make(map[string]globals.Pokemon)
+	}
+	return save, nil
+}
+
+// Save writes the save file to path, replacing the previous file only once
+// the new one has been written completely.
+func Save(path string, save SaveData) error {
+	data, err := json.Marshal(save)
+	if err != nil {
+		return fmt.Errorf("could not encode save data - %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("could not create save directory - %w", err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("could not write save file - %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("could not replace save file - %w", err)
+	}
+	return nil
+}
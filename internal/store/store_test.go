@@ -0,0 +1,41 @@
+package store
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/acehotel33/pokedex-cli/globals"
+)
+
+func TestSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "pokedex.json")
+
+	save, err := Load(path)
+	if err != nil {
+		t.Errorf("unexpected error loading missing file: %v", err)
+		return
+	}
+	if len(save.Pokedex) != 0 {
+		t.Errorf("expected empty pokedex, got %v", save.Pokedex)
+		return
+	}
+
+	save.Pokedex["pikachu"] = globals.Pokemon{Name: "pikachu", Height: 4}
+	save.Position = globals.Position{Region: "sinnoh", Location: "canalave-city", Area: "canalave-city-area"}
+	if err := Save(path, save); err != nil {
+		t.Errorf("unexpected error saving: %v", err)
+		return
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Errorf("unexpected error loading: %v", err)
+		return
+	}
+	if loaded.Pokedex["pikachu"].Height != 4 {
+		t.Errorf("expected to find saved pokemon, got %v", loaded.Pokedex)
+	}
+	if loaded.Position != save.Position {
+		t.Errorf("expected position %v, got %v", save.Position, loaded.Position)
+	}
+}
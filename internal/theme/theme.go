@@ -0,0 +1,171 @@
+package theme
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Palette maps roles to ANSI SGR parameters, e.g. "1;32" for bold green.
+// Pokemon types are roles named after the type, e.g. "fire".
+type Palette map[string]string
+
+// Roles used besides the pokemon type names.
+const (
+	RoleSuccess = "success"
+	RoleFailure = "failure"
+	RoleHeading = "heading"
+	RoleBar     = "bar"
+	RoleMuted   = "muted"
+)
+
+var palettes = map[string]Palette{
+	// default uses the 256 colour palette to get close to the games' type colours.
+	"default": {
+		RoleSuccess: "1;38;5;40",
+		RoleFailure: "1;38;5;196",
+		RoleHeading: "1",
+		RoleBar:     "38;5;75",
+		RoleMuted:   "38;5;244",
+		"normal":    "38;5;144",
+		"fire":      "38;5;202",
+		"water":     "38;5;33",
+		"electric":  "38;5;220",
+		"grass":     "38;5;70",
+		"ice":       "38;5;117",
+		"fighting":  "38;5;124",
+		"poison":    "38;5;127",
+		"ground":    "38;5;179",
+		"flying":    "38;5;141",
+		"psychic":   "38;5;205",
+		"bug":       "38;5;142",
+		"rock":      "38;5;136",
+		"ghost":     "38;5;61",
+		"dragon":    "38;5;57",
+		"dark":      "38;5;95",
+		"steel":     "38;5;146",
+		"fairy":     "38;5;218",
+	},
+	// basic sticks to the 16 standard colours for limited terminals.
+	"basic": {
+		RoleSuccess: "1;32",
+		RoleFailure: "1;31",
+		RoleHeading: "1",
+		RoleBar:     "36",
+		RoleMuted:   "2",
+		"normal":    "37",
+		"fire":      "31",
+		"water":     "34",
+		"electric":  "93",
+		"grass":     "32",
+		"ice":       "96",
+		"fighting":  "91",
+		"poison":    "35",
+		"ground":    "33",
+		"flying":    "94",
+		"psychic":   "95",
+		"bug":       "92",
+		"rock":      "33",
+		"ghost":     "35",
+		"dragon":    "94",
+		"dark":      "90",
+		"steel":     "37",
+		"fairy":     "95",
+	},
+}
+
+// Names lists the available themes.
+func Names() []string {
+	names := make([]string, 0, len(palettes))
+	for name := range palettes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Theme colours text. The zero value is a theme with colour disabled.
+type Theme struct {
+	palette Palette
+}
+
+// New returns the named theme, or a colourless theme if enabled is false.
+func New(name string, enabled bool) (Theme, error) {
+	palette, ok := palettes[name]
+	if !ok {
+		return Theme{}, fmt.Errorf("unknown theme %q - expected one of %s", name, strings.Join(Names(), ", "))
+	}
+	if !enabled {
+		return Theme{}, nil
+	}
+	return Theme{palette: palette}, nil
+}
+
+// Enabled reports whether colour should be used for a colour setting of
+// auto, always or never. Auto disables colour when NO_COLOR is set to a
+// non-empty value or the output is not a terminal.
+func Enabled(setting string, out any) bool {
+	switch setting {
+	case "always":
+		return true
+	case "never":
+		return false
+	}
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	return IsTerminal(out)
+}
+
+// IsTerminal reports whether out is a file attached to a terminal.
+func IsTerminal(out any) bool {
+	file, ok := out.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// Enabled reports whether the theme colours text at all.
+func (t Theme) Enabled() bool {
+	return t.palette != nil
+}
+
+// Paint wraps text in the colour of role, if the theme has one.
+func (t Theme) Paint(role, text string) string {
+	code, ok := t.palette[role]
+	if !ok {
+		return text
+	}
+	return "\033[" + code + "m" + text + "\033[0m"
+}
+
+func (t Theme) Success(text string) string { return t.Paint(RoleSuccess, text) }
+func (t Theme) Failure(text string) string { return t.Paint(RoleFailure, text) }
+func (t Theme) Heading(text string) string { return t.Paint(RoleHeading, text) }
+func (t Theme) Muted(text string) string   { return t.Paint(RoleMuted, text) }
+
+// Type colours text by a pokemon type name such as "fire".
+func (t Theme) Type(typeName, text string) string {
+	return t.Paint(typeName, text)
+}
+
+// Bar draws value as a bar of width cells, full at max.
+func (t Theme) Bar(value, max, width int) string {
+	if max <= 0 {
+		return ""
+	}
+	filled := value * width / max
+	if filled > width {
+		filled = width
+	}
+	if filled < 0 {
+		filled = 0
+	}
+	return t.Paint(RoleBar, strings.Repeat("█", filled)) + t.Muted(strings.Repeat("░", width-filled))
+}
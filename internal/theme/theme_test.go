@@ -0,0 +1,79 @@
+package theme
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestPaint(t *testing.T) {
+	cases := []struct {
+		name    string
+		enabled bool
+		colored bool
+	}{
+		{name: "default", enabled: true, colored: true},
+		{name: "basic", enabled: true, colored: true},
+		{name: "default", enabled: false, colored: false},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			th, err := New(c.name, c.enabled)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			out := th.Type("fire", "charmander")
+			if strings.Contains(out, "\033[") != c.colored {
+				t.Errorf("expected colored=%v, got %q", c.colored, out)
+			}
+			if !strings.Contains(out, "charmander") {
+				t.Errorf("expected text to be kept, got %q", out)
+			}
+		})
+	}
+
+	if _, err := New("neon", true); err == nil {
+		t.Errorf("expected unknown theme to be rejected")
+	}
+}
+
+func TestEnabled(t *testing.T) {
+	if Enabled("auto", &bytes.Buffer{}) {
+		t.Errorf("expected colour to be off for a non-terminal writer")
+	}
+	if !Enabled("always", &bytes.Buffer{}) {
+		t.Errorf("expected always to force colour on")
+	}
+	t.Setenv("NO_COLOR", "1")
+	if Enabled("never", nil) {
+		t.Errorf("expected never to force colour off")
+	}
+
+	// /dev/null is a character device, so it passes for a terminal.
+	tty, err := os.Open(os.DevNull)
+	if err != nil {
+		t.Skipf("no %s: %v", os.DevNull, err)
+	}
+	defer tty.Close()
+	if Enabled("auto", tty) {
+		t.Errorf("expected NO_COLOR to turn colour off")
+	}
+	t.Setenv("NO_COLOR", "")
+	if !Enabled("auto", tty) {
+		t.Errorf("expected an empty NO_COLOR to leave colour on")
+	}
+}
+
+func TestBar(t *testing.T) {
+	th := Theme{}
+	if bar := th.Bar(50, 100, 10); bar != "█████░░░░░" {
+		t.Errorf("unexpected bar %q", bar)
+	}
+	if bar := th.Bar(300, 255, 4); bar != "████" {
+		t.Errorf("expected bar to be capped, got %q", bar)
+	}
+}
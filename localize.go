@@ -0,0 +1,125 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/acehotel33/pokedex-cli/globals"
+	"github.com/acehotel33/pokedex-cli/internal/api"
+	"github.com/acehotel33/pokedex-cli/internal/i18n"
+	"github.com/acehotel33/pokedex-cli/internal/theme"
+)
+
+// maxNameLookups is how many names are looked up at once.
+const maxNameLookups = 8
+
+// localNames maps API names, such as "pikachu", to their names in the
+// display language.
+type localNames map[string]string
+
+// label returns the local name of an API name followed by the API name,
+// which is what commands take, or just the API name without a local name.
+func (n localNames) label(name string, th theme.Theme) string {
+	local, ok := n[name]
+	if !ok || local == name {
+		return name
+	}
+	return local + " " + th.Muted("("+name+")")
+}
+
+// nameLoader collects the names of resources in one language, along with
+// the lookups that failed.
+type nameLoader struct {
+	conf  *globals.Config
+	lang  string
+	names localNames
+	errs  []error
+}
+
+func newNameLoader(conf *globals.Config, lang string) *nameLoader {
+	return &nameLoader{conf: conf, lang: lang, names: localNames{}}
+}
+
+// load looks up the names of the given resources, e.g. of the "type"
+// resources "fire" and "water", a few at a time. In English the API names
+// are shown as they are, so nothing is looked up.
+func (l *nameLoader) load(resource string, names []string) {
+	if l.lang == i18n.Default {
+		return
+	}
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		pending = make(chan struct{}, maxNameLookups)
+		seen    = map[string]bool{}
+	)
+	for _, name := range names {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pending <- struct{}{}
+			resourceNames, err := api.GetNames(l.conf.Endpoint(resource, name), l.conf)
+			<-pending
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case errors.Is(err, api.ErrNotFound):
+				// A resource the API doesn't have, such as a form missing
+				// from pokemon-species, keeps its API name.
+			case err != nil:
+				l.errs = append(l.errs, fmt.Errorf("%s %s - %w", resource, name, err))
+			default:
+				if translated := localName(resourceNames, l.lang); translated != "" {
+					l.names[name] = translated
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// warning describes the lookups that failed, or is empty if none did. The
+// lookups finish in any order, so the first failure is picked by name.
+func (l *nameLoader) warning() string {
+	errs := slices.Clone(l.errs)
+	slices.SortFunc(errs, func(a, b error) int {
+		return strings.Compare(a.Error(), b.Error())
+	})
+	switch len(errs) {
+	case 0:
+		return ""
+	case 1:
+		return errs[0].Error()
+	}
+	return i18n.New(l.lang).Sprintf("%v, and %d more", errs[0], len(errs)-1)
+}
+
+// displayName returns the name of a resource in lang, falling back to its
+// English name and then to its API name, e.g. "Superball" for great-ball in
+// German.
+func displayName(names []globals.Name, lang, name string) string {
+	for _, l := range []string{lang, i18n.Default} {
+		if local := localName(names, l); local != "" {
+			return local
+		}
+	}
+	return name
+}
+
+func localName(names []globals.Name, lang string) string {
+	for _, name := range names {
+		if name.Language.Name == lang {
+			return name.Name
+		}
+	}
+	return ""
+}
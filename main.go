@@ -2,47 +2,258 @@ package main
 
 import (
 	"bufio"
+	"errors"
+	"flag"
 	"fmt"
-	"math/rand"
+	"io"
 	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/acehotel33/pokedex-cli/globals"
+	"github.com/acehotel33/pokedex-cli/internal/animation"
 	"github.com/acehotel33/pokedex-cli/internal/api"
+	"github.com/acehotel33/pokedex-cli/internal/cache"
+	"github.com/acehotel33/pokedex-cli/internal/capture"
+	"github.com/acehotel33/pokedex-cli/internal/i18n"
+	"github.com/acehotel33/pokedex-cli/internal/settings"
+	"github.com/acehotel33/pokedex-cli/internal/sprite"
+	"github.com/acehotel33/pokedex-cli/internal/store"
+	"github.com/acehotel33/pokedex-cli/internal/theme"
 )
 
 var cliCommandMap map[string]globals.CliCommand
 
 func main() {
-	// Initialize configuration
-	conf := &globals.Config{
-		NextURL:     globals.LocationsAllURL,
-		PreviousURL: "",
-		Pokedex:     make(map[string]globals.Pokemon),
+	output := flag.String("output", globals.OutputText, "output format: text or json")
+	jsonOutput := flag.Bool("json", false, "shorthand for --output json")
+	configPath := flag.String("config", defaultConfigPath(), "config file to read settings from")
+	pokedexPath := flag.String("pokedex", "", "file the caught pokemon are saved to, overrides the save_file setting")
+	lang := flag.String("lang", "", "language to show pokemon and places in, e.g. de or ja, overrides the lang setting")
+	fast := flag.Bool("fast", false, "skip the catch animation, shorthand for the animation setting off")
+	seed := flag.Int64("seed", 0, "seed for random rolls, to replay the same catches and encounters")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [command [arguments]]\n\n", os.Args[0])
+		fmt.Fprintln(flag.CommandLine.Output(), "Without a command the interactive Pokedex is started.")
+		fmt.Fprintln(flag.CommandLine.Output(), "Commands are the same as in the interactive Pokedex, see 'help'.")
+		fmt.Fprintln(flag.CommandLine.Output())
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if *jsonOutput {
+		*output = globals.OutputJSON
+	}
+	if *output != globals.OutputText && *output != globals.OutputJSON {
+		fmt.Fprintf(os.Stderr, "unknown output format %q - expected text or json\n", *output)
+		os.Exit(2)
+	}
+
+	s, err := settings.Load(*configPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if *pokedexPath != "" {
+		s.SaveFile = *pokedexPath
+	}
+	if *lang != "" {
+		if err := s.Set("lang", *lang); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
 	}
 
+	save, err := store.Load(s.SaveFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	conf := newConfig(s, os.Stdout)
+	conf.Pokedex = save.Pokedex
+	conf.Position = save.Position
+	conf.Encounter = save.Encounter
+	conf.Lead = save.Lead
+	if save.Inventory != nil {
+		conf.Inventory = save.Inventory
+	}
+	conf.ConfigPath = *configPath
+	conf.Output = *output
+	conf.Fast = *fast
+	if isFlagSet(flag.CommandLine, "seed") {
+		conf.Reseed(*seed)
+	}
+
+	if args := flag.Args(); len(args) > 0 {
+		os.Exit(runOnce(conf, args, os.Stderr))
+	}
+
+	// Commands piped in from a script run without the catch animation, even
+	// when the output goes to a terminal.
+	if !theme.IsTerminal(os.Stdin) {
+		conf.Fast = true
+	}
+	if err := runREPL(conf, os.Stdin); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// Sentinel errors returned by runCommand.
+var (
+	// errExit is returned by a command callback to end the REPL.
+	errExit = errors.New("exit requested")
+	// errUnknownCommand is returned for a command name not in cliCommandMap.
+	errUnknownCommand = errors.New("unknown command")
+)
+
+// runREPL reads commands line by line from in until EOF or an exit command,
+// writing all output to conf.Out.
+func runREPL(conf *globals.Config, in io.Reader) error {
+	scanner := bufio.NewScanner(in)
 	for {
-		scanner := bufio.NewScanner(os.Stdin)
-		fmt.Print("Pokedex > ")
+		fmt.Fprint(conf.Out, "Pokedex > ")
 
-		scanner.Scan()
-		line := scanner.Text()
-		words := strings.Split(line, " ")
+		if !scanner.Scan() {
+			fmt.Fprintln(conf.Out)
+			return scanner.Err()
+		}
+		words := strings.Fields(scanner.Text())
+		if len(words) == 0 {
+			continue
+		}
 
-		if command, exists := cliCommandMap[words[0]]; exists {
-			params := []string{}
-			if len(words) > 1 {
-				params = words[1:]
-			}
-			if err := command.Callback(conf, params); err != nil {
-				fmt.Printf("Could not perform command: %v\n", err)
+		err := runCommand(conf, words)
+		switch {
+		case err == nil:
+		case errors.Is(err, errExit):
+			return nil
+		case errors.Is(err, errUnknownCommand):
+			fmt.Fprintln(conf.Out, i18n.New(conf.Settings.Lang).Sprintf("Unknown command. Type 'help' for a list of commands."))
+		default:
+			renderError(conf.Out, conf, err)
+		}
+	}
+}
+
+// runOnce runs a single command given on the command line and returns the
+// process exit code: 0 on success, 1 if the command failed and 2 if it
+// could not be run at all.
+func runOnce(conf *globals.Config, args []string, errOut io.Writer) int {
+	// A single command is usually run from a script, which shouldn't wait
+	// for the catch animation.
+	conf.Fast = true
+	err := runCommand(conf, args)
+	switch {
+	case err == nil, errors.Is(err, errExit):
+		return 0
+	case errors.Is(err, errUnknownCommand):
+		fmt.Fprintln(errOut, i18n.New(conf.Settings.Lang).Sprintf("Unknown command %q. Run '%s help' for a list of commands.", args[0], filepath.Base(os.Args[0])))
+		return 2
+	default:
+		renderError(errOut, conf, err)
+		return 1
+	}
+}
+
+// runCommand looks up the callback for words[0] and runs it with the
+// remaining words as its parameters. A --json parameter switches the output
+// of this one command to JSON.
+func runCommand(conf *globals.Config, words []string) error {
+	command, exists := cliCommandMap[words[0]]
+	if !exists {
+		return errUnknownCommand
+	}
+
+	params := []string{}
+	for _, word := range words[1:] {
+		if word == "--json" || word == "-json" {
+			defer func(output string) { conf.Output = output }(conf.Output)
+			conf.Output = globals.OutputJSON
+			continue
+		}
+		params = append(params, word)
+	}
+
+	return command.Callback(conf, params)
+}
+
+// newConfig initializes the configuration for a session with the given
+// settings, writing its output to out.
+func newConfig(s settings.Settings, out io.Writer) *globals.Config {
+	conf := &globals.Config{
+		MapLimit:  s.PageSize,
+		Pokedex:   make(map[string]globals.Pokemon),
+		Inventory: startingInventory(),
+		Output:    globals.OutputText,
+		Out:       out,
+		Settings:  s,
+		Cache:     cache.NewCache(s.CacheTTL),
+		Theme:     newTheme(s, out),
+	}
+	conf.Reseed(time.Now().UnixNano())
+	return conf
+}
+
+// newAnimation returns the player for an animation written to conf.Out,
+// which is off when fast or conf.Fast is set.
+func newAnimation(conf *globals.Config, fast bool) animation.Player {
+	speed := conf.Settings.Animation
+	if fast || conf.Fast {
+		speed = animation.Off
+	}
+	return animation.New(conf.Out, speed, conf.Settings.AnimationDelay, theme.IsTerminal(conf.Out))
+}
+
+func newTheme(s settings.Settings, out io.Writer) theme.Theme {
+	// The theme name has already been validated by the settings.
+	th, _ := theme.New(s.Theme, theme.Enabled(s.Color, out))
+	return th
+}
+
+// parseFlags parses the flags in params, which may come before, between or
+// after the positional arguments, and returns the positional arguments.
+func parseFlags(fs *flag.FlagSet, params []string) ([]string, error) {
+	fs.SetOutput(io.Discard)
+	positional := []string{}
+	for {
+		if err := fs.Parse(params); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				usage := &strings.Builder{}
+				fs.SetOutput(usage)
+				fs.PrintDefaults()
+				return nil, i18n.Errorf("usage of %s:\n%s", fs.Name(), usage.String())
 			}
-		} else {
-			fmt.Println("Unknown command. Type 'help' for a list of commands.")
+			return nil, err
 		}
+		params = fs.Args()
+		if len(params) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, params[0])
+		params = params[1:]
+	}
+}
+
+func isFlagSet(fs *flag.FlagSet, name string) bool {
+	set := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
 
+func defaultConfigPath() string {
+	if path, ok := os.LookupEnv(settings.EnvPrefix + "CONFIG"); ok {
+		return path
 	}
+	return settings.DefaultPath()
 }
 
 func init() {
@@ -59,22 +270,22 @@ func init() {
 		},
 		"map": {
 			Name:        "map",
-			Description: "Displays first 20 locations of map, consecutive calls display next 20 locations",
+			Description: "Displays a page of locations, consecutive calls display the next page; map first|last, map --page N, map --limit N, map search <name>",
 			Callback:    commandMap,
 		},
 		"mapb": {
 			Name:        "mapb",
-			Description: "Displays the previous 20 locations of map",
+			Description: "Displays the previous page of locations, page_size at a time",
 			Callback:    commandMapB,
 		},
 		"explore": {
 			Name:        "explore",
-			Description: "Explore the specifed location for pokemon",
+			Description: "Explore the specifed location for pokemon, or the current area if none is given; --version <game>, --compact",
 			Callback:    commandExploreArea,
 		},
 		"catch": {
 			Name:        "catch",
-			Description: "Try to catch the specified pokemon",
+			Description: "Try to catch the specified pokemon; --ball poke|great|ultra|master, --form <form>",
 			Callback:    commandCatch,
 		},
 		"pokedex": {
@@ -87,215 +298,531 @@ func init() {
 			Description: "Inspect Pokemon's attributes if already caught",
 			Callback:    commandInspect,
 		},
+		"sprite": {
+			Name:        "sprite",
+			Description: "Draw the sprite of the specified pokemon; --shiny, --back, --gen i..viii",
+			Callback:    commandSprite,
+		},
+		"goto": {
+			Name:        "goto",
+			Description: "Travel to the specified location area",
+			Callback:    commandGoto,
+		},
+		"where": {
+			Name:        "where",
+			Description: "Show the region, location and area you are in; where <pokemon> [--version red] shows where a pokemon can be found",
+			Callback:    commandWhere,
+		},
+		"regions": {
+			Name:        "regions",
+			Description: "List all regions",
+			Callback:    commandRegions,
+		},
+		"locations": {
+			Name:        "locations",
+			Description: "List the locations of a region, the current one if none is given",
+			Callback:    commandLocations,
+		},
+		"areas": {
+			Name:        "areas",
+			Description: "List the areas of a location, the current one if none is given",
+			Callback:    commandAreas,
+		},
+		"walk": {
+			Name:        "walk",
+			Description: "Look for a wild pokemon in the current area; --method surf|old-rod|... to search another way",
+			Callback:    commandWalk,
+		},
+		"moves": {
+			Name:        "moves",
+			Description: "List the moves a pokemon learns: moves <pokemon> [--version-group red-blue] [--method level-up]",
+			Callback:    commandMoves,
+		},
+		"move": {
+			Name:        "move",
+			Description: "Show the power, accuracy, PP, type and effect of a move: move <name>",
+			Callback:    commandMove,
+		},
+		"evolutions": {
+			Name:        "evolutions",
+			Description: "Show the evolution tree of a pokemon and how each evolution is triggered: evolutions <pokemon>",
+			Callback:    commandEvolutions,
+		},
+		"evolve": {
+			Name:        "evolve",
+			Description: "Evolve a caught pokemon that meets the conditions: evolve <pokemon> [into]",
+			Callback:    commandEvolve,
+		},
+		"ability": {
+			Name:        "ability",
+			Description: "Show what an ability does and which pokemon have it: ability <name>",
+			Callback:    commandAbility,
+		},
+		"matchup": {
+			Name:        "matchup",
+			Description: "Show type weaknesses, resistances and immunities: matchup <pokemon|type> or matchup <attacker> vs <defender>",
+			Callback:    commandMatchup,
+		},
+		"lead": {
+			Name:        "lead",
+			Description: "Show your lead pokemon, or lead <pokemon> to send another one out to fight",
+			Callback:    commandLead,
+		},
+		"fight": {
+			Name:        "fight",
+			Description: "Fight the wild pokemon with your lead pokemon, optionally with a move: fight [move]",
+			Callback:    commandFight,
+		},
+		"heal": {
+			Name:        "heal",
+			Description: "Restores the HP and cures the status of every caught pokemon",
+			Callback:    commandHeal,
+		},
+		"inventory": {
+			Name:        "inventory",
+			Description: "Show the balls in your bag; inventory restock refills it",
+			Callback:    commandInventory,
+		},
+		"cry": {
+			Name:        "cry",
+			Description: "Play the cry of the specified pokemon; --legacy for the original games' cry, --save <file> to keep it",
+			Callback:    commandCry,
+		},
+		"seed": {
+			Name:        "seed",
+			Description: "Show the random seed, or seed <number> to replay the rolls that follow it",
+			Callback:    commandSeed,
+		},
+		"lang": {
+			Name:        "lang",
+			Description: "Show the display language, or lang <code> to switch to another, e.g. de or ja",
+			Callback:    commandLang,
+		},
+		"config": {
+			Name:        "config",
+			Description: "Show or change settings: config list, config get <key>, config set <key> <value>",
+			Callback:    commandConfig,
+		},
 	}
 }
 
 func commandHelp(conf *globals.Config, params []string) error {
-	defer fmt.Println(".\n.")
-	fmt.Println(".\n.")
-	fmt.Println("Welcome to the Pokedex!")
-	fmt.Println("Usage:")
-	fmt.Println()
-
-	for key, cmd := range cliCommandMap {
-		fmt.Printf("%s: %s\n", key, cmd.Description)
+	res := helpResult{msg: i18n.New(conf.Settings.Lang)}
+	for _, key := range sortedKeys(cliCommandMap) {
+		res.Commands = append(res.Commands, helpEntry{
+			Name:        key,
+			Description: cliCommandMap[key].Description,
+		})
 	}
-
-	return nil
+	return render(conf, res)
 }
 
 func commandExit(conf *globals.Config, params []string) error {
-	defer fmt.Println(".\n.")
-	fmt.Println(".\n.")
-	fmt.Println("Exiting")
-	os.Exit(0)
-	return nil
+	if err := render(conf, messageResult{msg: i18n.New(conf.Settings.Lang), Message: "Exiting"}); err != nil {
+		return err
+	}
+	return errExit
 }
 
 func commandMap(conf *globals.Config, params []string) error {
-	defer fmt.Println(".\n.")
-	fmt.Println(".\n.")
-	nextURL := conf.NextURL
-
-	locations, err := api.GetLocationAreasAll(nextURL, conf)
+	fs := flag.NewFlagSet("map", flag.ContinueOnError)
+	page := fs.Int("page", 0, "jump to this page")
+	limit := fs.Int("limit", 0, "number of locations per page for the rest of the session")
+	args, err := parseFlags(fs, params)
 	if err != nil {
 		return err
 	}
 
-	for _, location := range locations {
-		fmt.Println(location.Name)
+	pageSet, limitSet := isFlagSet(fs, "page"), isFlagSet(fs, "limit")
+
+	target := conf.MapPage + 1
+	if limitSet && *limit < 1 {
+		return i18n.Errorf("limit must be a positive number")
+	}
+	if limitSet {
+		// Stay on the page showing the first location of the current one.
+		first := 0
+		if conf.MapPage > 0 {
+			first = (conf.MapPage - 1) * conf.MapLimit
+		}
+		conf.MapLimit = *limit
+		target = first/conf.MapLimit + 1
 	}
-	return nil
-}
 
-func commandMapB(conf *globals.Config, params []string) error {
-	defer fmt.Println(".\n.")
-	fmt.Println(".\n.")
+	if len(args) > 0 {
+		switch args[0] {
+		case "search":
+			return commandMapSearch(conf, args[1:])
+		case "first":
+			target = 1
+		case "last":
+			if conf.MapCount == 0 {
+				// The number of pages is only known after the first fetch.
+				if _, err := fetchMapPage(conf, 1); err != nil {
+					return err
+				}
+			}
+			target = mapPages(conf)
+		default:
+			return i18n.Errorf("unknown argument %q - expected first, last or search", args[0])
+		}
+	}
+	if pageSet {
+		target = *page
+	}
 
-	previousURL := conf.PreviousURL
+	if conf.MapCount > 0 && target > mapPages(conf) {
+		if !pageSet && !limitSet && len(args) == 0 {
+			return render(conf, messageResult{msg: i18n.New(conf.Settings.Lang), Message: "Already on the last page"})
+		}
+		return i18n.Errorf("there are only %d pages", mapPages(conf))
+	}
+	return showMapPage(conf, target)
+}
 
-	if previousURL == "" {
-		fmt.Println("Already on Page 1")
-		return nil
+func commandMapB(conf *globals.Config, params []string) error {
+	if conf.MapPage <= 1 {
+		return render(conf, messageResult{msg: i18n.New(conf.Settings.Lang), Message: "Already on Page 1"})
 	}
+	return showMapPage(conf, conf.MapPage-1)
+}
 
-	locations, err := api.GetLocationAreasAll(previousURL, conf)
+func showMapPage(conf *globals.Config, page int) error {
+	locations, err := fetchMapPage(conf, page)
 	if err != nil {
 		return err
 	}
+	// The number of pages may only be known now, after the first fetch.
+	if page > 1 && page > mapPages(conf) {
+		return i18n.Errorf("there are only %d pages", mapPages(conf))
+	}
+	conf.MapPage = page
 
-	for _, location := range locations {
-		fmt.Println(location.Name)
+	names := make([]string, 0, len(locations.Results))
+	for _, location := range locations.Results {
+		names = append(names, location.Name)
 	}
-	return nil
+	local := newNameLoader(conf, conf.Settings.Lang)
+	local.load("location-area", names)
+	return render(conf, mapResult{
+		msg:         i18n.New(conf.Settings.Lang),
+		Names:       local.names,
+		Warning:     local.warning(),
+		Locations:   locations.Results,
+		Page:        page,
+		Pages:       mapPages(conf),
+		Count:       locations.Count,
+		NextURL:     locations.NextURL,
+		PreviousURL: locations.PreviousURL,
+	})
+}
+
+// fetchMapPage fetches a page of location areas, counting from 1, and
+// records the total number of location areas.
+func fetchMapPage(conf *globals.Config, page int) (globals.LocationAreasAll, error) {
+	if page < 1 {
+		return globals.LocationAreasAll{}, i18n.Errorf("page must be at least 1")
+	}
+
+	url := fmt.Sprintf("%s?offset=%d&limit=%d", conf.Endpoint("location-area", ""), (page-1)*conf.MapLimit, conf.MapLimit)
+	locations, err := api.GetLocationAreas(url, conf)
+	if err != nil {
+		return globals.LocationAreasAll{}, err
+	}
+	conf.MapCount = locations.Count
+	return locations, nil
+}
+
+func mapPages(conf *globals.Config) int {
+	return (conf.MapCount + conf.MapLimit - 1) / conf.MapLimit
 }
 
 func commandExploreArea(conf *globals.Config, params []string) error {
-	defer fmt.Println(".\n.")
-	fmt.Println(".\n.")
+	fs := flag.NewFlagSet("explore", flag.ContinueOnError)
+	version := fs.String("version", conf.Settings.Version, "only show encounters in this game version, e.g. diamond, or all")
+	compact := fs.Bool("compact", false, "only list the names of the pokemon")
+	params, err := parseFlags(fs, params)
+	if err != nil {
+		return err
+	}
 
 	if len(params) < 1 {
-		return fmt.Errorf("missing argument")
+		if conf.Position.Area == "" {
+			return i18n.Errorf("missing argument - give an area or 'goto' one first")
+		}
+		params = []string{conf.Position.Area}
 	}
 	location := params[0]
 	if location == " " {
-		return fmt.Errorf("empty location given")
+		return i18n.Errorf("empty location given")
 	}
-	fullURL := globals.LocationsAllURL + location
-	pokemonSplice, err := api.ExploreArea(fullURL, conf)
+	fullURL := conf.Endpoint("location-area", location)
+	area, err := api.GetArea(fullURL, conf)
 	if err != nil {
-		return fmt.Errorf("could not explore area - %w", err)
+		return i18n.Errorf("could not explore area - %w", err)
 	}
-	fmt.Printf("Exploring %s...\n", location)
-	fmt.Println("Found Pokemon:")
-	for _, pokemon := range pokemonSplice {
-		fmt.Printf("- %s\n", pokemon)
+
+	res := exploreResult{
+		compact:  *compact,
+		msg:      i18n.New(conf.Settings.Lang),
+		Location: location,
+		Version:  gameVersion(*version),
+		Pokemon:  []string{},
 	}
-	return nil
+	for _, encounter := range area.PokemonEncounters {
+		summaries := summarizeEncounters(encounter.VersionDetails, res.Version)
+		if res.Version != "" && len(summaries) == 0 {
+			continue
+		}
+		res.Pokemon = append(res.Pokemon, encounter.Pokemon.Name)
+		if !res.compact {
+			res.Encounters = append(res.Encounters, pokemonEncounters{
+				Pokemon:    encounter.Pokemon.Name,
+				Encounters: summaries,
+			})
+		}
+	}
+	if !res.compact {
+		res.MethodRates = summarizeMethodRates(area, res.Version)
+	}
+	names := newNameLoader(conf, conf.Settings.Lang)
+	names.load("pokemon-species", res.Pokemon)
+	names.load("location-area", []string{location})
+	res.Names, res.Warning = names.names, names.warning()
+	return render(conf, res)
 }
 
 func commandCatch(conf *globals.Config, params []string) error {
-	fmt.Println(".\n.")
+	fs := flag.NewFlagSet("catch", flag.ContinueOnError)
+	ballName := fs.String("ball", "poke", "ball to throw: poke, great, ultra or master")
+	fast := fs.Bool("fast", false, "skip the catch animation")
+	form := fs.String("form", "", "catch the pokemon in another form, e.g. alola")
+	params, err := parseFlags(fs, params)
+	if err != nil {
+		return err
+	}
+	ball, err := findBall(*ballName)
+	if err != nil {
+		return err
+	}
+
+	encounter := conf.Encounter
+	if conf.Settings.Mode == "game" {
+		if encounter == nil {
+			return i18n.Errorf("there is no wild pokemon here - use 'walk' to look for one")
+		}
+		if len(params) > 0 && params[0] != encounter.Pokemon {
+			return i18n.Errorf("there is no wild %s here, only a wild %s", params[0], encounter.Pokemon)
+		}
+		if *form != "" {
+			return i18n.Errorf("wild pokemon can't be caught in another form")
+		}
+		params = []string{encounter.Pokemon}
+	}
 
 	if len(params) < 1 {
-		return fmt.Errorf("catch command missing arguments")
+		return i18n.Errorf("catch command missing arguments")
 	}
 	toCatch := params[0]
-	fullURL := globals.PokemonURL + toCatch
+	fullURL := conf.Endpoint("pokemon", toCatch)
 	pokemon, err := api.GetPokemon(fullURL, conf)
 	if err != nil {
-		return fmt.Errorf("could not find pokemon - %w", err)
+		return i18n.Errorf("could not find pokemon - %w", err)
+	}
+	species, err := api.GetSpecies(pokemon.Species.URL, conf)
+	if err != nil {
+		return i18n.Errorf("could not look up species of %s - %w", pokemon.Name, err)
+	}
+	if *form != "" {
+		if pokemon, err = findForm(conf, pokemon, species, *form); err != nil {
+			return err
+		}
 	}
 
 	if _, exists := conf.Pokedex[pokemon.Name]; exists {
-		return fmt.Errorf("pokemon %s already in pokedex", pokemon.Name)
+		return i18n.Errorf("pokemon %s already in pokedex", pokemon.Name)
+	}
+
+	if conf.Inventory[ball.name] < 1 {
+		return i18n.Errorf("you have no %s left - see 'inventory'", ball.name)
+	}
+	item, err := api.GetItem(conf.Endpoint("item", ball.name), conf)
+	if err != nil {
+		return i18n.Errorf("could not look up %s - %w", ball.name, err)
 	}
+	conf.Inventory[ball.name]--
 
-	if result := helperCatch(pokemon); result {
+	throw := capture.Params{
+		CaptureRate: species.CaptureRate,
+		BallBonus:   ball.modifier,
+		Guaranteed:  ball.guaranteed,
+	}
+	wild := encounter != nil && encounter.Pokemon == pokemon.Name
+	if wild {
+		throw.HP, throw.MaxHP, throw.Status = encounter.HP, encounter.MaxHP, encounter.Status
+	}
+	outcome := capture.Attempt(throw, conf.Rand)
+	caught := outcome.Caught
+	if caught {
+		if wild {
+			pokemon.Level = encounter.Level
+		}
+		// Any catch ends the encounter: the wild pokemon was caught, or it
+		// ran off while another one was caught by name.
+		conf.Encounter = nil
+		pokemon.Shiny = conf.Rand.Intn(conf.Settings.ShinyOdds) == 0
 		if err := addToPokedex(conf, pokemon); err != nil {
-			return fmt.Errorf("pokemon %s already caught", pokemon.Name)
+			return i18n.Errorf("could not add %s to pokedex - %w", pokemon.Name, err)
 		}
-		fmt.Printf("Result: Success! You caught %v!\n", pokemon.Name)
-	} else {
-		fmt.Printf("Result: Oh no! %v slipped away!\n", pokemon.Name)
+	} else if err := saveGame(conf); err != nil {
+		return err
 	}
 
-	time.Sleep(time.Second)
-	fmt.Println(".\n.")
-	fmt.Println("Current Pokedex:")
-	if err := commandPokedex(conf, params); err != nil {
-		return fmt.Errorf("could not display pokedex - %w", err)
-	}
-	return nil
+	return render(conf, catchResult{
+		animation:   newAnimation(conf, *fast),
+		msg:         i18n.New(conf.Settings.Lang),
+		pokemonType: primaryType(pokemon),
+		Pokemon:     pokemon.Name,
+		Form:        pokemon.Form,
+		Shiny:       pokemon.Shiny,
+		CaptureRate: species.CaptureRate,
+		Ball:        displayName(item.Names, conf.Settings.Lang, item.Name),
+		BallsLeft:   conf.Inventory[ball.name],
+		Chance:      capture.Probability(throw) * 100,
+		Shakes:      outcome.Shakes,
+		Threshold:   outcome.Threshold,
+		Rolls:       outcome.Rolls,
+		Caught:      caught,
+		Pokedex:     newPokedexResult(conf),
+	})
 }
 
 func commandInspect(conf *globals.Config, params []string) error {
-	fmt.Println(".\n.")
+	fs := flag.NewFlagSet("inspect", flag.ContinueOnError)
+	version := fs.String("version", conf.Settings.Version, "show the pokedex entry of this game version, e.g. red, or all for the latest")
+	lang := fs.String("lang", conf.Settings.Lang, "language to show the pokemon in, e.g. en or de")
+	showSprite := fs.Bool("sprite", false, "draw the pokemon's sprite, even with the sprite setting off")
+	params, err := parseFlags(fs, params)
+	if err != nil {
+		return err
+	}
+	if !i18n.Valid(*lang) {
+		return i18n.Errorf("lang must be one of %s", strings.Join(i18n.Languages, ", "))
+	}
 	if len(params) < 1 {
-		return fmt.Errorf("missing parameter")
+		return i18n.Errorf("missing parameter")
 	}
 	pokemonToInspect := params[0]
-	if poke, exists := conf.Pokedex[pokemonToInspect]; !exists {
-		return fmt.Errorf("you have not caught that pokemon")
-	} else {
-		fmt.Printf("Name: %s\n", poke.Name)
-		fmt.Printf("Height: %v\n", poke.Height)
-		fmt.Printf("Weight: %v\n", poke.Weight)
-
-		fmt.Println("Stats:")
-		stats := poke.Stats
-		for _, stat := range stats {
-			statName := stat.Stat.Name
-			statValue := stat.BaseStat
-			fmt.Printf("  -%s: %v\n", statName, statValue)
-		}
+	poke, exists := conf.Pokedex[pokemonToInspect]
+	if !exists {
+		return i18n.Errorf("you have not caught that pokemon")
+	}
 
-		fmt.Println("Types:")
-		pTypes := poke.Types
-		for _, pType := range pTypes {
-			pTypeName := pType.Type.Name
-			fmt.Printf("  - %s\n", pTypeName)
+	res := inspectResult{msg: i18n.New(*lang), Pokemon: poke}
+	abilities := []string{}
+	for _, ability := range poke.Abilities {
+		abilities = append(abilities, ability.Ability.Name)
+	}
+	names := newNameLoader(conf, *lang)
+	names.load("pokemon-species", []string{poke.Name})
+	names.load("type", pokemonTypes(poke))
+	names.load("ability", abilities)
+	res.Names, res.Warning = names.names, names.warning()
+	// Pokemon saved before species were looked up may not know theirs.
+	if poke.Species.URL != "" {
+		species, err := api.GetSpecies(poke.Species.URL, conf)
+		if err != nil {
+			return i18n.Errorf("could not look up species of %s - %w", poke.Name, err)
 		}
+		info := newSpeciesInfo(species, gameVersion(*version), *lang)
+		res.Species = &info
 	}
-	fmt.Println(".\n.")
-	return nil
-}
-
-func commandPokedex(conf *globals.Config, params []string) error {
-	fmt.Println(".\n.")
-	if len(conf.Pokedex) == 0 {
-		fmt.Println("Pokedex is empty!")
-		return nil
+	mode := conf.Settings.Sprite
+	if *showSprite && mode == sprite.Off {
+		mode = sprite.Auto
 	}
-	for key := range conf.Pokedex {
-		fmt.Printf("- %s -\n", key)
+	// The sprite is only looked up when it is drawn or its URL is printed.
+	if mode == sprite.Off && conf.Output != globals.OutputJSON {
+		return render(conf, res)
 	}
-	return nil
+	sprites, err := caughtSprites(conf, poke)
+	if err != nil {
+		return err
+	}
+	res.SpriteURL = sprites.front
+	if poke.Shiny && sprites.frontShiny != "" {
+		res.SpriteURL = sprites.frontShiny
+	}
+	if mode != sprite.Off && res.SpriteURL != "" {
+		if res.sprite, err = loadSprite(conf, res.SpriteURL, mode); err != nil {
+			return err
+		}
+	}
+	return render(conf, res)
 }
 
-func helperCatch(pokemon globals.Pokemon) bool {
-
-	baseExperience := pokemon.BaseExperience
-	fmt.Printf("Base Experience of %s: %v\n", pokemon.Name, baseExperience)
+func commandPokedex(conf *globals.Config, params []string) error {
+	return render(conf, newPokedexResult(conf))
+}
 
-	// Normalize baseExperience to a minimum of 100 and maximum of 500
-	if baseExperience < 100 {
-		baseExperience = 100
-	} else if baseExperience > 500 {
-		baseExperience = 500
+func commandSeed(conf *globals.Config, params []string) error {
+	if len(params) > 0 {
+		seed, err := strconv.ParseInt(params[0], 10, 64)
+		if err != nil {
+			return i18n.Errorf("invalid seed %q - expected a whole number", params[0])
+		}
+		conf.Reseed(seed)
 	}
+	return render(conf, seedResult{msg: i18n.New(conf.Settings.Lang), Seed: conf.Seed})
+}
 
-	// Calculate a realistic chance using baseExperience
-	// Higher baseExperience should result in a lower chance
-	chance := (500.0 - float64(baseExperience)) / 5.0
-
-	fmt.Printf("Chance of success: %.1f percent\n", chance)
-
-	// Use a random seed
-	src := rand.NewSource(time.Now().UnixNano())
-	r := rand.New(src)
-
-	// Determine success based on random number
-	result := r.Intn(100)
-
-	time.Sleep(2 * time.Second)
-	fmt.Println(".\n.")
-	fmt.Printf("Throwing a Pokeball at %s...", pokemon.Name)
-	fmt.Println("")
-	for i := 0; i < 4; i++ {
-		time.Sleep(1 * time.Second)
-		fmt.Println(".")
+func addToPokedex(conf *globals.Config, pokemon globals.Pokemon) error {
+	if _, exists := conf.Pokedex[pokemon.Name]; exists {
+		return i18n.Errorf("pokemon %s already in pokedex", pokemon.Name)
 	}
 
-	return result < int(chance)
+	conf.Pokedex[pokemon.Name] = pokemon
+	// The first pokemon caught leads until another one is chosen.
+	if conf.Lead == "" {
+		conf.Lead = pokemon.Name
+	}
+	return saveGame(conf)
+}
 
+// saveGame writes the pokedex, position, current encounter and inventory to
+// the save file, if there is one.
+func saveGame(conf *globals.Config) error {
+	if conf.Settings.SaveFile == "" {
+		return nil
+	}
+	return store.Save(conf.Settings.SaveFile, store.SaveData{
+		Pokedex:   conf.Pokedex,
+		Position:  conf.Position,
+		Encounter: conf.Encounter,
+		Inventory: conf.Inventory,
+		Lead:      conf.Lead,
+	})
 }
 
-func addToPokedex(conf *globals.Config, pokemon globals.Pokemon) error {
-	if _, exists := conf.Pokedex[pokemon.Name]; exists {
-		return fmt.Errorf("pokemon %s already in pokedex", pokemon.Name)
+// primaryType returns the name of the pokemon's first type, or "" if its
+// types are unknown.
+func primaryType(pokemon globals.Pokemon) string {
+	for _, pType := range pokemon.Types {
+		if pType.Slot == 1 {
+			return pType.Type.Name
+		}
+	}
+	if len(pokemon.Types) > 0 {
+		return pokemon.Types[0].Type.Name
 	}
+	return ""
+}
 
-	conf.Pokedex[pokemon.Name] = pokemon
-	return nil
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
 }
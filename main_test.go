@@ -0,0 +1,977 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/acehotel33/pokedex-cli/globals"
+	"github.com/acehotel33/pokedex-cli/internal/animation"
+	"github.com/acehotel33/pokedex-cli/internal/settings"
+	"github.com/acehotel33/pokedex-cli/internal/theme"
+)
+
+func newTestConfig(t *testing.T, output string) (*globals.Config, *bytes.Buffer) {
+	t.Helper()
+	server := newStubServer(t)
+
+	s := settings.Default()
+	s.APIURL = server.URL + "/"
+	s.AnimationDelay = 0
+	s.SaveFile = ""
+	// No catch is shiny by chance; tests wanting one set shiny_odds to 1.
+	s.ShinyOdds = math.MaxInt32
+
+	out := &bytes.Buffer{}
+	conf := newConfig(s, out)
+	conf.Output = output
+	return conf, out
+}
+
+// runCommands runs the input through the REPL and returns what it printed.
+func runCommands(t *testing.T, conf *globals.Config, out *bytes.Buffer, input string) string {
+	t.Helper()
+	start := out.Len()
+	if err := runREPL(conf, strings.NewReader(input)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return out.String()[start:]
+}
+
+// expectOutput checks that the output contains each of the expected strings.
+func expectOutput(t *testing.T, output string, expected []string) {
+	t.Helper()
+	for _, want := range expected {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+// expectMissing checks that the output contains none of the unwanted strings.
+func expectMissing(t *testing.T, output string, unwanted []string) {
+	t.Helper()
+	for _, s := range unwanted {
+		if strings.Contains(output, s) {
+			t.Errorf("expected output not to contain %q, got:\n%s", s, output)
+		}
+	}
+}
+
+// runJSON runs the input through the REPL with JSON output and returns the
+// document printed for each command.
+func runJSON(t *testing.T, conf *globals.Config, out *bytes.Buffer, input string) []string {
+	t.Helper()
+	conf.Output = globals.OutputJSON
+	output := runCommands(t, conf, out, input)
+	docs := strings.Split(strings.TrimSpace(strings.ReplaceAll(output, "Pokedex > ", "")), "\n")
+	if commands := strings.Count(strings.TrimSpace(input), "\n") + 1; len(docs) != commands {
+		t.Fatalf("expected %d json documents, got:\n%s", commands, output)
+	}
+	return docs
+}
+
+// decodeJSON decodes a document printed by runJSON into v.
+func decodeJSON(t *testing.T, doc string, v any) {
+	t.Helper()
+	if err := json.Unmarshal([]byte(doc), v); err != nil {
+		t.Fatalf("could not decode %T from %s: %v", v, doc, err)
+	}
+}
+
+// jsonError returns the error of a document printed by runJSON, or "" if the
+// command succeeded.
+func jsonError(t *testing.T, doc string) string {
+	t.Helper()
+	var res struct {
+		Error string `json:"error"`
+	}
+	decodeJSON(t, doc, &res)
+	return res.Error
+}
+
+func TestREPLText(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected []string
+		missing  []string
+	}{
+		{
+			input:    "map\nmap\n",
+			expected: []string{"canalave-city-area", "pastoria-city-area", "page 1 of 1", "Already on the last page"},
+		},
+		{
+			input:    "map --page 50\nmap\n",
+			expected: []string{"there are only 1 pages", "pastoria-city-area\npage 1 of 1"},
+			missing:  []string{"page 50 of 1"},
+		},
+		{
+			input:    "map --limit 1\nmap\nmapb\nmap last\nmap --page 2\nmap first\nmap --page 4\nmap --limit 2\n",
+			expected: []string{"canalave-city-area\npage 1 of 3", "eterna-city-area\npage 2 of 3", "pastoria-city-area\npage 3 of 3", "there are only 3 pages", "canalave-city-area\neterna-city-area\npage 1 of 2"},
+		},
+		{
+			input:    "map search city area\nmap search pstr\nmap search zubat\n",
+			expected: []string{"     2  eterna-city-area\n     1  canalave-city-area\n     3  pastoria-city-area\n3 matches", "     3  pastoria-city-area\n1 match -", `No location areas match "zubat".`},
+		},
+		{
+			input:    "config set page_size 2\nmap search city area\n",
+			expected: []string{"     2  eterna-city-area\n     1  canalave-city-area\n3 matches - explore an area by name or id\n1 more not shown"},
+		},
+		{
+			input:    "map --page 0\nmap sideways\nmap --height 3\n",
+			expected: []string{"page must be at least 1", `unknown argument "sideways"`, "flag provided but not defined: -height"},
+		},
+		{
+			input:    "mapb\n",
+			expected: []string{"Already on Page 1"},
+		},
+		{
+			input:    "explore canalave-city-area\n",
+			expected: []string{"Exploring canalave-city-area...", "- tentacool", "- staryu"},
+		},
+		{
+			input:    "explore canalave-city-area --version diamond\n",
+			expected: []string{"Version: diamond", "- tentacool\n    surf         lv 20-30   60%  diamond\nEncounter rates:\n    surf          10%"},
+			missing:  []string{"staryu", "platinum"},
+		},
+		{
+			input:    "explore --compact canalave-city-area\n",
+			expected: []string{"Found Pokemon:\n- tentacool\n- staryu\n."},
+			missing:  []string{"surf"},
+		},
+		{
+			input:    "config set version platinum\nexplore canalave-city-area\n",
+			expected: []string{"- staryu\n    good-rod     lv 10-20   40%  platinum", "    surf          20%"},
+		},
+		{
+			input:    "explore nowhere\n",
+			expected: []string{"Could not perform command: could not explore area - location not found"},
+		},
+		{
+			input:    "pokedex\ninspect pikachu\n",
+			expected: []string{"Pokedex is empty!", "you have not caught that pokemon"},
+		},
+		{
+			input:    "dance\n\n",
+			expected: []string{"Unknown command."},
+		},
+		{
+			input:    "exit\nmap\n",
+			expected: []string{"Exiting"},
+			missing:  []string{"canalave-city-area"},
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			conf, out := newTestConfig(t, globals.OutputText)
+			output := runCommands(t, conf, out, c.input)
+			expectOutput(t, output, c.expected)
+			expectMissing(t, output, c.missing)
+		})
+	}
+}
+
+func TestREPLInspect(t *testing.T) {
+	conf, out := newTestConfig(t, globals.OutputText)
+	var pikachu globals.Pokemon
+	if err := json.Unmarshal([]byte(`{"name":"pikachu","height":4,"weight":60,"stats":[{"base_stat":35,"stat":{"name":"hp"}}],"types":[{"slot":1,"type":{"name":"electric"}}]}`), &pikachu); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	conf.Pokedex["pikachu"] = pikachu
+
+	output := runCommands(t, conf, out, "pokedex\ninspect pikachu\n")
+	expectOutput(t, output, []string{"- pikachu -", "Name: pikachu", "Height: 4", "Weight: 60", "-hp:               35 ██░"})
+}
+
+func TestREPLJSON(t *testing.T) {
+	conf, out := newTestConfig(t, globals.OutputJSON)
+
+	docs := runJSON(t, conf, out, "explore canalave-city-area\ncatch pikachu --ball master\nexplore nowhere\n")
+
+	var explored exploreResult
+	decodeJSON(t, docs[0], &explored)
+	if len(explored.Pokemon) != 2 || explored.Pokemon[0] != "tentacool" {
+		t.Errorf("unexpected explore result: %+v", explored)
+	}
+
+	var caught catchResult
+	decodeJSON(t, docs[1], &caught)
+	if caught.Pokemon != "pikachu" || !caught.Caught || caught.Shakes != 4 || caught.Ball != "Master Ball" {
+		t.Errorf("unexpected catch result: %+v", caught)
+	}
+	if _, ok := conf.Pokedex["pikachu"]; !ok {
+		t.Errorf("expected pikachu in the pokedex, got %v", conf.Pokedex)
+	}
+
+	if jsonError(t, docs[2]) == "" {
+		t.Errorf("expected error document, got %s", docs[2])
+	}
+}
+
+func TestRunOnce(t *testing.T) {
+	cases := []struct {
+		args     []string
+		code     int
+		expected string
+	}{
+		{
+			args:     []string{"explore", "canalave-city-area"},
+			code:     0,
+			expected: "Exploring canalave-city-area...",
+		},
+		{
+			args:     []string{"explore", "canalave-city-area", "--json"},
+			code:     0,
+			expected: `{"location":"canalave-city-area","pokemon":["tentacool","staryu"],"encounters":[`,
+		},
+		{
+			args:     []string{"inspect", "bulbasaur"},
+			code:     1,
+			expected: "you have not caught that pokemon",
+		},
+		{
+			args:     []string{"config", "get", "animation"},
+			code:     0,
+			expected: "animation = normal",
+		},
+		{
+			args:     []string{"dance"},
+			code:     2,
+			expected: `Unknown command "dance"`,
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			conf, out := newTestConfig(t, globals.OutputText)
+			code := runOnce(conf, c.args, out)
+			if code != c.code {
+				t.Errorf("expected exit code %d, got %d", c.code, code)
+			}
+			expectOutput(t, out.String(), []string{c.expected})
+			if conf.Output != globals.OutputText {
+				t.Errorf("expected --json to apply to a single command only")
+			}
+			if !conf.Fast {
+				t.Errorf("expected the animation to be off for a single command")
+			}
+			if conf.Settings.Animation != animation.Normal {
+				t.Errorf("expected the animation setting to be kept, got %s", conf.Settings.Animation)
+			}
+		})
+	}
+}
+
+func TestConfigCommand(t *testing.T) {
+	conf, out := newTestConfig(t, globals.OutputText)
+	conf.ConfigPath = filepath.Join(t.TempDir(), "config.json")
+
+	input := "config set page_size 5\nconfig get page_size\nconfig set page_size none\nconfig list\n"
+	output := runCommands(t, conf, out, input)
+	expectOutput(t, output, []string{"page_size = 5", "page_size must be a positive number", "api_url = "})
+	if conf.MapLimit != 5 {
+		t.Errorf("expected map to use the new page size, got %d", conf.MapLimit)
+	}
+
+	runCommands(t, conf, out, fmt.Sprintf("map search canalave\nconfig set api_url %s\n", conf.Settings.APIURL))
+	if conf.AreaIndex != nil {
+		t.Errorf("expected a new api_url to clear the area index")
+	}
+
+	saved, err := settings.LoadFile(conf.ConfigPath)
+	if err != nil {
+		t.Errorf("unexpected error loading config file: %v", err)
+		return
+	}
+	if saved.PageSize != 5 {
+		t.Errorf("expected page_size to be saved, got %d", saved.PageSize)
+	}
+}
+
+func TestNavigation(t *testing.T) {
+	conf, out := newTestConfig(t, globals.OutputText)
+
+	input := "where\nexplore\nlocations\ngoto canalave-city-area\nwhere\nregions\nlocations\nareas\nexplore\ngoto nowhere\n"
+	output := runCommands(t, conf, out, input)
+
+	expectOutput(t, output, []string{
+		"You haven't travelled anywhere yet.",
+		"missing argument - give an area or 'goto' one first",
+		"missing region",
+		"Travelled to canalave-city-area!",
+		"You are in canalave-city-area.",
+		"* sinnoh (you are here)",
+		"- kanto",
+		"Locations in sinnoh:",
+		"* canalave-city (you are here)",
+		"- eterna-city",
+		"Areas in canalave-city:",
+		"Exploring canalave-city-area...",
+		"could not travel to nowhere - location not found",
+	})
+
+	expected := globals.Position{Region: "sinnoh", Location: "canalave-city", Area: "canalave-city-area"}
+	if conf.Position != expected {
+		t.Errorf("expected position %v, got %v", expected, conf.Position)
+	}
+}
+
+func TestWherePokemon(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected []string
+		missing  []string
+	}{
+		{
+			input: "where pikachu\n",
+			expected: []string{
+				"viridian-forest-area\n  - walk         lv 2-6     10%  red, yellow",
+				"power-plant-area\n  - walk         lv 20-24   25%  yellow",
+			},
+		},
+		{
+			input:    "where pikachu --version red\n",
+			expected: []string{"  - walk         lv 3-5      8%  red"},
+			missing:  []string{"power-plant-area"},
+		},
+		{
+			input:    "where --version gold pikachu\n",
+			expected: []string{"pikachu can't be found in the wild in gold."},
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			conf, out := newTestConfig(t, globals.OutputText)
+			output := runCommands(t, conf, out, c.input)
+			expectOutput(t, output, c.expected)
+			expectMissing(t, output, c.missing)
+		})
+	}
+}
+
+func TestGameMode(t *testing.T) {
+	conf, out := newTestConfig(t, globals.OutputText)
+	conf.Settings.Mode = "game"
+
+	input := "catch starly\ngoto route-201-area\nwalk --method surf\nwalk\ncatch pikachu\ncatch --ball master\n"
+	output := runCommands(t, conf, out, input)
+	expectOutput(t, output, []string{
+		"there is no wild pokemon here - use 'walk' to look for one",
+		"no pokemon can be found with surf here - try --method old-rod, walk",
+		"A wild starly (lv 2) appeared!",
+		"there is no wild pikachu here, only a wild starly",
+		"Throwing a Master Ball at starly...",
+		"You caught starly!",
+	})
+
+	starly, caught := conf.Pokedex["starly"]
+	if !caught || starly.Level != 2 {
+		t.Errorf("expected starly caught at level 2, got %+v", starly)
+	}
+	if conf.Encounter != nil {
+		t.Errorf("expected the encounter to be over, got %+v", conf.Encounter)
+	}
+}
+
+func TestWalkNothing(t *testing.T) {
+	conf, out := newTestConfig(t, globals.OutputText)
+	conf.Settings.Mode = "game"
+
+	// The old rod never finds anything on route 201.
+	input := "goto route-201-area\nwalk\nwalk --method old-rod\ncatch starly\n"
+	output := runCommands(t, conf, out, input)
+	expectOutput(t, output, []string{
+		"A wild starly (lv 2) appeared!",
+		"Nothing appeared.",
+		"there is no wild pokemon here - use 'walk' to look for one",
+	})
+	if conf.Encounter != nil {
+		t.Errorf("expected the starly to be gone, got %+v", conf.Encounter)
+	}
+}
+
+func TestWildVersion(t *testing.T) {
+	conf, out := newTestConfig(t, globals.OutputText)
+	conf.Settings.Mode = "game"
+	conf.Reseed(1)
+
+	// Route 201 has a level 40 starly and pidgey in red, which is older
+	// than diamond.
+	output := runCommands(t, conf, out, "goto route-201-area\n"+strings.Repeat("walk\n", 20))
+	if got := strings.Count(output, "A wild starly (lv 2) appeared!"); got != 20 {
+		t.Errorf("expected only the starly of diamond to appear, got %d of 20:\n%s", got, output)
+	}
+
+	output = runCommands(t, conf, out, "config set version red\nwalk\n")
+	expectOutput(t, output, []string{"(lv 40) appeared!"})
+}
+
+func TestLookupCatchEndsEncounter(t *testing.T) {
+	conf, out := newTestConfig(t, globals.OutputText)
+
+	conf.Settings.Mode = "game"
+	runCommands(t, conf, out, "goto route-201-area\nwalk\n")
+	conf.Settings.Mode = "lookup"
+	runCommands(t, conf, out, "catch starly --ball master\n")
+	if starly := conf.Pokedex["starly"]; starly.Level != 2 || conf.Encounter != nil {
+		t.Errorf("expected the wild starly caught at level 2 and the encounter over, got %+v and %+v", starly, conf.Encounter)
+	}
+
+	conf.Settings.Mode = "game"
+	runCommands(t, conf, out, "walk\n")
+	conf.Settings.Mode = "lookup"
+	runCommands(t, conf, out, "inventory restock\ncatch pikachu --ball master\n")
+	if conf.Encounter != nil {
+		t.Errorf("expected the wild pokemon to run off, got %+v", conf.Encounter)
+	}
+}
+
+func TestInventory(t *testing.T) {
+	conf, out := newTestConfig(t, globals.OutputText)
+
+	input := "inventory\ncatch pikachu --ball master --fast\ncatch --ball master eevee\ncatch eevee --ball rubber\ninventory restock\n"
+	output := runCommands(t, conf, out, input)
+	expectOutput(t, output, []string{
+		"  Poke Ball     x10  Tries to catch a wild Pokémon.",
+		"  Master Ball    x1",
+		"Chance of success: 100.0 percent",
+		"Throwing a Master Ball at pikachu...",
+		"You caught pikachu!",
+		"Master Ball left: 0",
+		"you have no master-ball left",
+		`unknown ball "rubber-ball"`,
+	})
+	if conf.Inventory["master-ball"] != 1 {
+		t.Errorf("expected restock to refill the master ball, got %v", conf.Inventory)
+	}
+}
+
+func TestSeed(t *testing.T) {
+	input := "seed 42\ncatch bulbasaur\ncatch charmander\ncatch squirtle\ncatch caterpie\ncatch weedle\nseed\nseed lucky\n"
+
+	outputs := []string{}
+	for i := 0; i < 2; i++ {
+		conf, out := newTestConfig(t, globals.OutputText)
+		outputs = append(outputs, runCommands(t, conf, out, input))
+	}
+
+	if outputs[0] != outputs[1] {
+		t.Errorf("expected the same seed to replay the same catches, got:\n%s\nand:\n%s", outputs[0], outputs[1])
+		return
+	}
+	expectOutput(t, outputs[0], []string{
+		"Random seed: 42",
+		`invalid seed "lucky"`,
+	})
+}
+
+func TestBattle(t *testing.T) {
+	conf, out := newTestConfig(t, globals.OutputText)
+
+	input := "seed 1\nfight\ncatch pikachu --ball master\nlead\nlead eevee\ngoto route-201-area\nwalk\nfight hyper-beam\nfight\nfight tackle\nfight thunder-wave\nheal\nfight thunder-wave\n"
+	output := runCommands(t, conf, out, input)
+	expectOutput(t, output, []string{
+		"there is no wild pokemon here - use 'walk' to look for one",
+		"pikachu leads your team.",
+		"you have not caught eevee",
+		"pikachu can't attack with hyper-beam - it knows tackle, thunder-wave",
+		"pikachu (lv 2) vs wild starly (lv 2)",
+		"pikachu used tackle! starly lost",
+		"starly used tackle! pikachu lost",
+		"pikachu used thunder-wave! starly is paralyzed!",
+		"pikachu used thunder-wave! But it failed!",
+	})
+
+	if conf.Encounter == nil || conf.Encounter.HP >= conf.Encounter.MaxHP || conf.Encounter.Status != "paralysis" {
+		t.Errorf("expected a weakened, paralyzed wild starly, got %+v", conf.Encounter)
+	}
+	if pikachu := conf.Pokedex["pikachu"]; pikachu.HP == 0 || pikachu.Fainted {
+		t.Errorf("expected a wounded pikachu, got %d HP", pikachu.HP)
+	}
+}
+
+func TestFainting(t *testing.T) {
+	conf, out := newTestConfig(t, globals.OutputText)
+	conf.Reseed(1)
+
+	runCommands(t, conf, out, "catch pikachu --ball master\ngoto route-201-area\nwalk\n")
+	// The wild starly can't faint from one hit, so its attack knocks out
+	// a pikachu with 1 HP left.
+	pikachu := conf.Pokedex["pikachu"]
+	pikachu.HP = 1
+	conf.Pokedex["pikachu"] = pikachu
+
+	output := runCommands(t, conf, out, "fight\nwalk\nfight\nheal\nfight\n")
+	expectOutput(t, output, []string{
+		"pikachu fainted! The wild starly got away.",
+		"pikachu has fainted - use 'heal' or choose another lead with 'lead <pokemon>'",
+		"Your pokemon are fully healed.",
+	})
+	if got := strings.Count(output, "pikachu (lv 2) vs wild starly (lv 2)"); got != 2 {
+		t.Errorf("expected pikachu to fight again once healed, got %d fights", got)
+	}
+	if pikachu := conf.Pokedex["pikachu"]; pikachu.Fainted {
+		t.Errorf("expected a healed pikachu, got %+v", pikachu)
+	}
+}
+
+func TestMatchup(t *testing.T) {
+	conf, out := newTestConfig(t, globals.OutputText)
+
+	input := "matchup pikachu\nmatchup rock\nmatchup pikachu vs rock\nmatchup rock vs pikachu\nmatchup pikachu rock\n"
+	output := runCommands(t, conf, out, input)
+	expectOutput(t, output, []string{
+		"Matchups of pikachu (normal):",
+		"  - fighting   2x",
+		"Immune to:\n  - ghost",
+		"Matchups of rock type:",
+		"  - normal     0.5x",
+		"pikachu (normal) vs rock type:\n  - normal moves: 0.5x not very effective",
+		"rock type vs pikachu (normal):\n  - rock moves: 1x normal damage",
+		"usage: matchup <pokemon|type> or matchup <attacker> vs <defender>",
+	})
+}
+
+func TestColouredColumns(t *testing.T) {
+	conf, out := newTestConfig(t, globals.OutputText)
+	th, err := theme.New("basic", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	conf.Theme = th
+
+	input := "catch pikachu --ball master\ninventory\nmatchup pikachu\nmatchup rock\n"
+	output := runCommands(t, conf, out, input)
+	plain := regexp.MustCompile("\x1b\\[[0-9;]*m").ReplaceAllString(output, "")
+	for _, want := range []string{
+		"  Master Ball    x0  ",
+		"  - fighting   2x",
+		"  - normal     0.5x",
+	} {
+		if !strings.Contains(plain, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, plain)
+		}
+	}
+}
+
+func TestMoves(t *testing.T) {
+	conf, out := newTestConfig(t, globals.OutputText)
+
+	input := "moves pikachu\nmoves pikachu --version-group red-blue --method level-up\nmove tackle\nmove growl\nmove thunder-shock\nmove splash\n"
+	output := runCommands(t, conf, out, input)
+	expectOutput(t, output, []string{
+		"Moves of pikachu:\nlevel-up:\n  - lv 1   tackle           red-blue, yellow\n  - lv 1   thunder-wave     yellow\n  - lv 5   growl            red-blue\nmachine:\n  -        growl            yellow",
+		"Moves of pikachu in red-blue:\nlevel-up:\n  - lv 1   tackle\n  - lv 5   growl\n",
+		"Tackle (tackle)\nType: normal\nCategory: physical\nPower: 40\nAccuracy: 100\nPP: 35\nEffect: Inflicts regular damage with no additional effect.",
+		"growl (growl)\nType: normal\nCategory: status\nPower: -",
+		"Effect: Has a 10% chance to paralyze the target.",
+		"could not find move - move not found",
+	})
+}
+
+func TestAbility(t *testing.T) {
+	conf, out := newTestConfig(t, globals.OutputText)
+
+	input := "catch pikachu --ball master\ninspect pikachu\nability static\nability overgrow\n"
+	output := runCommands(t, conf, out, input)
+	expectOutput(t, output, []string{
+		"Abilities:\n  - static\n  - lightning-rod (hidden)\n",
+		"Static (static)\nIntroduced in: generation-iii\nEffect: Has a 30% chance of paralyzing attacking Pokémon on contact.",
+		"Pokemon with this ability:\n  - pikachu\n  - electrike (hidden)\n",
+		"could not find ability - ability not found",
+	})
+}
+
+func TestEvolutions(t *testing.T) {
+	conf, out := newTestConfig(t, globals.OutputText)
+	var charmander globals.Pokemon
+	if err := json.Unmarshal([]byte(`{"name":"charmander","level":20,"shiny":true,"form":"charmander-b","species":{"name":"charmander","url":"`+conf.Settings.APIURL+`pokemon-species/charmander/"}}`), &charmander); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	conf.Pokedex["charmander"] = charmander
+	conf.Lead = "charmander"
+
+	input := "evolutions charmander\nevolve charmander charizard\nevolve charmander\nevolve charmeleon\n"
+	output := runCommands(t, conf, out, input)
+	expectOutput(t, output, []string{
+		"Evolutions of charmander:\ncharmander\n├─ charmeleon (level 16)\n│  └─ charizard (level 36)\n└─ charmeleon-traded (trade, holding metal-coat)\n",
+		"charmander does not evolve into charizard",
+		"Congratulations! Your charmander evolved into charmeleon!",
+		"charmeleon can't evolve yet: charizard needs level 36 (it is level 20)",
+	})
+
+	charmeleon, evolved := conf.Pokedex["charmeleon"]
+	if _, stayed := conf.Pokedex["charmander"]; stayed || !evolved || charmeleon.Level != 20 || conf.Lead != "charmeleon" {
+		t.Errorf("expected charmander to be replaced by a level 20 charmeleon, got %v and lead %v", sortedKeys(conf.Pokedex), conf.Lead)
+	}
+	if !charmeleon.Shiny || charmeleon.Form != "charmeleon-b" {
+		t.Errorf("expected the shiny form charmander-b to evolve into a shiny charmeleon-b, got shiny %v and form %q", charmeleon.Shiny, charmeleon.Form)
+	}
+}
+
+func TestEvolveVarieties(t *testing.T) {
+	conf, out := newTestConfig(t, globals.OutputText)
+	for _, caught := range []string{
+		`{"name":"charmander-alola","level":20,"species":{"name":"charmander","url":"` + conf.Settings.APIURL + `pokemon-species/charmander/"}}`,
+		`{"name":"charmeleon","level":40,"species":{"name":"charmeleon","url":"` + conf.Settings.APIURL + `pokemon-species/charmeleon/"}}`,
+	} {
+		var pokemon globals.Pokemon
+		decodeJSON(t, caught, &pokemon)
+		conf.Pokedex[pokemon.Name] = pokemon
+	}
+
+	output := runCommands(t, conf, out, "evolve charmander-alola\nevolve charmeleon\n")
+	expectOutput(t, output, []string{
+		"Your charmander-alola evolved into charmeleon-alola!",
+		"Your charmeleon evolved into charizard-standard!",
+	})
+	if got := sortedKeys(conf.Pokedex); !slices.Equal(got, []string{"charizard-standard", "charmeleon-alola"}) {
+		t.Errorf("expected the alolan variety and charizard's default variety, got %v", got)
+	}
+}
+
+func TestInspectSpecies(t *testing.T) {
+	conf, out := newTestConfig(t, globals.OutputText)
+
+	input := "catch pikachu --ball master\ninspect pikachu\ninspect pikachu --version red\ninspect pikachu --lang de\n"
+	output := runCommands(t, conf, out, input)
+	expectOutput(t, output, []string{
+		"Name: pikachu\nGenus: Mouse Pokémon\n",
+		"Pokedex entry (yellow):\n  It keeps its tail raised to monitor its surroundings.\n",
+		"Pokedex entry (red):\n  When several of these POKéMON gather, their electricity could build and cause lightning storms.\n",
+		"Kategorie: Maus-Pokémon",
+		"Pokedex-Eintrag (x):\n  Es kann Elektrizität speichern.\n",
+		"Habitat: forest\nColor: yellow\nShape: quadruped\nGeneration: generation-i\nGender: 50% female, 50% male\n",
+	})
+}
+
+func TestLocalization(t *testing.T) {
+	conf, out := newTestConfig(t, globals.OutputText)
+
+	input := "catch pikachu --ball master\nlang ja\npokedex\ninspect pikachu\nexplore canalave-city-area --compact\nmap\nwalk\nlang de\nfly\ninspect mew\nlang klingon\nlang fr\ninspect pikachu --lang klingon\ninspect pikachu --lang en\n"
+	output := runCommands(t, conf, out, input)
+	expectOutput(t, output, []string{
+		"lang = ja",
+		"- pikachu-ja (pikachu) -",
+		"名前: pikachu-ja (pikachu)",
+		"タイプ:\n  - ノーマル (normal)\n",
+		"  - せいでんき (static)\n  - lightning-rod (隠れ特性)\n",
+		"ミオシティ (canalave-city-area)を探索中...\n見つけたポケモン:\n- tentacool-ja (tentacool)\n- staryu-ja (staryu)\n",
+		"ミオシティ (canalave-city-area)\neterna-city-area\npastoria-city-area\n1 / 1 ページ\n一部の名前を翻訳できませんでした: location-area pastoria-city-area - status code of response is not OK - 500 Internal Server Error\n",
+		"コマンドを実行できません: エリアにいません - 先に 'goto <area>' を使ってください",
+		"Unbekannter Befehl. Gib 'help' ein, um alle Befehle zu sehen.",
+		"Befehl fehlgeschlagen: dieses Pokemon hast du nicht gefangen",
+		"Befehl fehlgeschlagen: lang muss eine von en, de",
+		"Impossible d'exécuter la commande : lang doit être l'une de en, de",
+		"Name: pikachu\n",
+	})
+}
+
+func TestLocalizedNames(t *testing.T) {
+	conf, out := newTestConfig(t, globals.OutputText)
+
+	input := "lang ja\ninventory\nmove tackle\nability static\nmoves pikachu\nmove no-such-move\ncatch pikachu --ball great\n"
+	output := runCommands(t, conf, out, input)
+	expectOutput(t, output, []string{
+		"バッグ:\n  poke-ball-ja",
+		"たいあたり (tackle)\nタイプ: normal\n分類: physical\n威力: 40\n",
+		"せいでんき (static)\n初登場: generation-iii\n",
+		"この 特性を 持つ ポケモン:\n  - pikachu\n",
+		"pikachuの わざ:\nlevel-up:\n  - Lv.1   たいあたり (tackle)",
+		"コマンドを実行できません: わざが 見つかりません - ",
+		"great-ball-jaをpikachuに投げた...",
+	})
+	expectMissing(t, output, []string{"Bag:", "Great Ball"})
+}
+
+func TestNameWarning(t *testing.T) {
+	names := newNameLoader(nil, "en")
+	names.errs = []error{errors.New("type water - 500"), errors.New("type fire - 500"), errors.New("type grass - 500")}
+	if got, expected := names.warning(), "type fire - 500, and 2 more"; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestSprite(t *testing.T) {
+	cases := []struct {
+		input string
+		url   string
+		err   string
+	}{
+		{input: "sprite pikachu", url: "pikachu.png"},
+		{input: "sprite pikachu --shiny", url: "shiny/pikachu.png"},
+		{input: "sprite pikachu --gen i", url: "red-blue/pikachu.png"},
+		{input: "sprite pikachu --shiny --gen i", err: "pikachu has no such sprite in generation i"},
+		{input: "sprite pikachu --gen x", err: `unknown generation "x"`},
+		{input: "sprite pikachu --back", err: "pikachu has no such sprite"},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			conf, out := newTestConfig(t, globals.OutputJSON)
+			doc := runJSON(t, conf, out, c.input+"\n")[0]
+			if c.err != "" {
+				if got := jsonError(t, doc); !strings.HasPrefix(got, c.err) {
+					t.Errorf("expected error %q, got %q", c.err, got)
+				}
+				return
+			}
+			var drawn spriteResult
+			decodeJSON(t, doc, &drawn)
+			if want := conf.Settings.APIURL + "sprites/" + c.url; drawn.Pokemon != "pikachu" || drawn.URL != want {
+				t.Errorf("expected the sprite of pikachu at %s, got %+v", want, drawn)
+			}
+			if _, downloaded := conf.Cache.Get(drawn.URL); downloaded {
+				t.Errorf("expected json output not to download the sprite")
+			}
+		})
+	}
+}
+
+func TestDrawSprite(t *testing.T) {
+	conf, out := newTestConfig(t, globals.OutputText)
+
+	input := "sprite pikachu\ncatch pikachu --ball master\ninspect pikachu\ninspect pikachu --sprite\nconfig set sprite ascii\ninspect pikachu\n"
+	output := runCommands(t, conf, out, input)
+	expectOutput(t, output, []string{".\n.\n+\n.\n.", ".\n.\nName: pikachu", ".\n.\n+\nName: pikachu\n"})
+	if strings.Count(output, "+\nName: pikachu") != 2 {
+		t.Errorf("expected inspect to draw the sprite with --sprite and the sprite setting, got:\n%s", output)
+	}
+}
+
+func TestShinyAndForms(t *testing.T) {
+	cases := []struct {
+		catch   string
+		shiny   bool
+		pokemon string
+		form    string
+		err     string
+	}{
+		{catch: "pikachu", shiny: true, pokemon: "pikachu"},
+		{catch: "pikachu --form alola", pokemon: "pikachu-alola"},
+		{catch: "pikachu --form b", pokemon: "pikachu", form: "pikachu-b"},
+		{catch: "pikachu --form x", err: "pikachu has no form x - expected one of pikachu-alola, pikachu-b"},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			conf, out := newTestConfig(t, globals.OutputJSON)
+			if c.shiny {
+				conf.Settings.ShinyOdds = 1
+			}
+			if c.err != "" {
+				doc := runJSON(t, conf, out, "catch "+c.catch+" --ball master\n")[0]
+				if got := jsonError(t, doc); got != c.err {
+					t.Errorf("expected error %q, got %q", c.err, got)
+				}
+				return
+			}
+
+			docs := runJSON(t, conf, out, "catch "+c.catch+" --ball master\ninspect "+c.pokemon+"\npokedex\n")
+			var caught catchResult
+			var inspected inspectResult
+			var pokedex pokedexResult
+			decodeJSON(t, docs[0], &caught)
+			decodeJSON(t, docs[1], &inspected)
+			decodeJSON(t, docs[2], &pokedex)
+
+			if !caught.Caught || caught.Pokemon != c.pokemon || caught.Form != c.form || caught.Shiny != c.shiny {
+				t.Errorf("expected to catch %s in form %q with shiny %v, got %+v", c.pokemon, c.form, c.shiny, caught)
+			}
+			if poke := inspected.Pokemon; poke.Name != c.pokemon || poke.Form != c.form || poke.Shiny != c.shiny {
+				t.Errorf("expected inspect to show %s in form %q with shiny %v, got %+v", c.pokemon, c.form, c.shiny, poke)
+			}
+			if !slices.Equal(pokedex.Pokemon, []string{c.pokemon}) || (len(pokedex.Shiny) == 1) != c.shiny {
+				t.Errorf("expected only %s in the pokedex with shiny %v, got %+v", c.pokemon, c.shiny, pokedex)
+			}
+		})
+	}
+}
+
+// recordingPlayer keeps the audio it is asked to play.
+type recordingPlayer struct {
+	played []string
+}
+
+func (p *recordingPlayer) Play(data []byte) error {
+	p.played = append(p.played, string(data))
+	return nil
+}
+
+// TestHelperPlayer isn't a real test: TestCry runs the test binary as the
+// cry player, which copies the cry to the file after it.
+func TestHelperPlayer(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") != "1" {
+		return
+	}
+	args := os.Args
+	for len(args) > 0 && args[0] != "--" {
+		args = args[1:]
+	}
+	if len(args) != 3 {
+		fmt.Fprintln(os.Stderr, "usage: -- <cry> <copy>")
+		os.Exit(2)
+	}
+	data, err := os.ReadFile(args[1])
+	if err == nil {
+		err = os.WriteFile(args[2], data, 0o644)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+func TestCry(t *testing.T) {
+	t.Setenv("GO_WANT_HELPER_PROCESS", "1")
+	dir := t.TempDir()
+	saved, played := filepath.Join(dir, "save.ogg"), filepath.Join(dir, "played.ogg")
+	// Quotes keep the space in this path in one argument of the player.
+	spaced := filepath.Join(dir, "my cries", "played.ogg")
+	if err := os.Mkdir(filepath.Dir(spaced), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		input  string
+		player bool
+		err    string
+		played bool
+		saved  string
+		file   string // where the cry should be written
+	}{
+		{
+			input: "cry pikachu\n",
+			err:   "no player for cries",
+		},
+		{
+			input:  "cry pikachu\n",
+			player: true,
+			played: true,
+		},
+		{
+			input:  "cry pikachu --legacy\n",
+			player: true,
+			err:    "pikachu has no legacy cry",
+		},
+		{
+			input: "cry pikachu --save " + saved + "\n",
+			saved: saved,
+			file:  saved,
+		},
+		{
+			input:  "config set cry_player " + os.Args[0] + " -test.run=^TestHelperPlayer$ -- {} " + played + "\ncry pikachu\n",
+			played: true,
+			file:   played,
+		},
+		{
+			input:  "config set cry_player " + os.Args[0] + " -test.run=^TestHelperPlayer$ -- {} '" + spaced + "'\ncry pikachu\n",
+			played: true,
+			file:   spaced,
+		},
+		{
+			input: "config set cry_player '" + os.Args[0] + "\ncry pikachu\n",
+			err:   "invalid cry_player - unterminated ' quote",
+		},
+	}
+
+	for i, c := range cases {
+		t.Run(fmt.Sprintf("Test case %v", i), func(t *testing.T) {
+			conf, out := newTestConfig(t, globals.OutputJSON)
+			player := &recordingPlayer{}
+			if c.player {
+				conf.Player = player
+			}
+			docs := runJSON(t, conf, out, c.input)
+			doc := docs[len(docs)-1]
+			if c.err != "" {
+				if got := jsonError(t, doc); !strings.HasPrefix(got, c.err) {
+					t.Errorf("expected error %q, got %q", c.err, got)
+				}
+				if len(player.played) != 0 {
+					t.Errorf("expected no cry to be played, got %q", player.played)
+				}
+				return
+			}
+
+			var cried cryResult
+			decodeJSON(t, doc, &cried)
+			if cried.Pokemon != "pikachu" || cried.Played != c.played || cried.Saved != c.saved {
+				t.Errorf("expected the cry of pikachu with played %v and saved %q, got %+v", c.played, c.saved, cried)
+			}
+			if c.player && (len(player.played) != 1 || player.played[0] != "OggS pikachu") {
+				t.Errorf("expected the cry of pikachu to be played once, got %q", player.played)
+			}
+			if c.file == "" {
+				return
+			}
+			if data, err := os.ReadFile(c.file); err != nil || string(data) != "OggS pikachu" {
+				t.Errorf("expected the cry in %s, got %q (%v)", c.file, data, err)
+			}
+		})
+	}
+}
+
+func TestCryText(t *testing.T) {
+	conf, out := newTestConfig(t, globals.OutputText)
+	conf.Player = &recordingPlayer{}
+
+	output := runCommands(t, conf, out, "lang de\ncry pikachu\ncry pikachu --legacy\n")
+	expectOutput(t, output, []string{
+		"pikachu schreit!",
+		"Befehl fehlgeschlagen: pikachu hat keinen Schrei aus den ersten Spielen",
+	})
+}
+
+func TestFormSprites(t *testing.T) {
+	conf, out := newTestConfig(t, globals.OutputJSON)
+	conf.Settings.ShinyOdds = 1
+
+	input := "catch pikachu --form b --ball master\ninspect pikachu\nsprite pikachu\nsprite pikachu --form pikachu\n"
+	docs := runJSON(t, conf, out, input)
+
+	var inspected inspectResult
+	var drawn, plain spriteResult
+	decodeJSON(t, docs[1], &inspected)
+	decodeJSON(t, docs[2], &drawn)
+	decodeJSON(t, docs[3], &plain)
+	base := conf.Settings.APIURL + "sprites/"
+	if inspected.SpriteURL != base+"form/shiny/pikachu-b.png" {
+		t.Errorf("expected inspect to use the shiny sprite of the form, got %s", inspected.SpriteURL)
+	}
+	if drawn.Form != "pikachu-b" || drawn.URL != base+"form/pikachu-b.png" {
+		t.Errorf("expected sprite to default to the form caught, got %+v", drawn)
+	}
+	if plain.URL != base+"form/pikachu.png" {
+		t.Errorf("expected --form to pick the form, got %+v", plain)
+	}
+}
+
+func TestMissingFormSprite(t *testing.T) {
+	conf, out := newTestConfig(t, globals.OutputText)
+	runCommands(t, conf, out, "catch pikachu --form b --ball master\n")
+	pikachu := conf.Pokedex["pikachu"]
+	pikachu.Form = "pikachu-missing"
+	conf.Pokedex["pikachu"] = pikachu
+
+	output := runCommands(t, conf, out, "inspect pikachu\ninspect pikachu --sprite\n")
+	expectOutput(t, output, []string{"Form: pikachu-missing\n", ".\n.\n+\nName: pikachu\n"})
+
+	var inspected inspectResult
+	decodeJSON(t, runJSON(t, conf, out, "inspect pikachu\n")[0], &inspected)
+	if want := conf.Settings.APIURL + "sprites/pikachu.png"; inspected.SpriteURL != want {
+		t.Errorf("expected the sprite of the pokemon itself, got %s", inspected.SpriteURL)
+	}
+}
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/acehotel33/pokedex-cli/globals"
+	"github.com/acehotel33/pokedex-cli/internal/api"
+	"github.com/acehotel33/pokedex-cli/internal/i18n"
+	"github.com/acehotel33/pokedex-cli/internal/theme"
+)
+
+// matchupSide is a pokemon, or a bare type, on one side of a matchup.
+type matchupSide struct {
+	Name    string   `json:"name"`
+	Pokemon bool     `json:"pokemon"`
+	Types   []string `json:"types"`
+}
+
+// typeMultiplier is how much damage moves of Type do.
+type typeMultiplier struct {
+	Type       string  `json:"type"`
+	Multiplier float64 `json:"multiplier"`
+}
+
+func commandMatchup(conf *globals.Config, params []string) error {
+	switch {
+	case len(params) == 1:
+		return commandDefensiveMatchup(conf, params[0])
+	case len(params) == 3 && params[1] == "vs":
+		return commandVersusMatchup(conf, params[0], params[2])
+	default:
+		return i18n.Errorf("usage: matchup <pokemon|type> or matchup <attacker> vs <defender>")
+	}
+}
+
+func commandDefensiveMatchup(conf *globals.Config, name string) error {
+	side, err := findMatchupSide(conf, name)
+	if err != nil {
+		return err
+	}
+
+	// Every attacking type starts out doing normal damage, and each type of
+	// a dual type pokemon multiplies that.
+	multipliers := map[string]float64{}
+	for _, typeName := range side.Types {
+		pokemonType, err := api.GetType(conf.Endpoint("type", typeName), conf)
+		if err != nil {
+			return i18n.Errorf("could not look up type %s - %w", typeName, err)
+		}
+		for attackType, multiplier := range damageFrom(pokemonType) {
+			if _, seen := multipliers[attackType]; !seen {
+				multipliers[attackType] = 1
+			}
+			multipliers[attackType] *= multiplier
+		}
+	}
+
+	res := defensiveMatchupResult{
+		msg:         i18n.New(conf.Settings.Lang),
+		Defender:    side,
+		Weaknesses:  []typeMultiplier{},
+		Resistances: []typeMultiplier{},
+		Immunities:  []string{},
+	}
+	for _, attackType := range sortedKeys(multipliers) {
+		multiplier := multipliers[attackType]
+		switch {
+		case multiplier == 0:
+			res.Immunities = append(res.Immunities, attackType)
+		case multiplier > 1:
+			res.Weaknesses = append(res.Weaknesses, typeMultiplier{Type: attackType, Multiplier: multiplier})
+		case multiplier < 1:
+			res.Resistances = append(res.Resistances, typeMultiplier{Type: attackType, Multiplier: multiplier})
+		}
+	}
+	sort.SliceStable(res.Weaknesses, func(i, j int) bool {
+		return res.Weaknesses[i].Multiplier > res.Weaknesses[j].Multiplier
+	})
+	sort.SliceStable(res.Resistances, func(i, j int) bool {
+		return res.Resistances[i].Multiplier < res.Resistances[j].Multiplier
+	})
+	return render(conf, res)
+}
+
+func commandVersusMatchup(conf *globals.Config, attackerName, defenderName string) error {
+	attacker, err := findMatchupSide(conf, attackerName)
+	if err != nil {
+		return err
+	}
+	defender, err := findMatchupSide(conf, defenderName)
+	if err != nil {
+		return err
+	}
+
+	chart, err := loadTypeChart(conf, attacker.Types)
+	if err != nil {
+		return err
+	}
+	res := versusMatchupResult{msg: i18n.New(conf.Settings.Lang), Attacker: attacker, Defender: defender, Attacks: []typeMultiplier{}}
+	for _, attackType := range attacker.Types {
+		res.Attacks = append(res.Attacks, typeMultiplier{
+			Type:       attackType,
+			Multiplier: chart.Effectiveness(attackType, defender.Types),
+		})
+	}
+	return render(conf, res)
+}
+
+// findMatchupSide looks name up as a type and, failing that, as a pokemon.
+func findMatchupSide(conf *globals.Config, name string) (matchupSide, error) {
+	pokemonType, err := api.GetType(conf.Endpoint("type", name), conf)
+	if err == nil {
+		return matchupSide{Name: pokemonType.Name, Types: []string{pokemonType.Name}}, nil
+	}
+	if !errors.Is(err, api.ErrNotFound) {
+		return matchupSide{}, i18n.Errorf("could not look up %s - %w", name, err)
+	}
+
+	pokemon, err := api.GetPokemon(conf.Endpoint("pokemon", name), conf)
+	if errors.Is(err, api.ErrNotFound) {
+		return matchupSide{}, i18n.Errorf("there is no pokemon or type called %s", name)
+	}
+	if err != nil {
+		return matchupSide{}, i18n.Errorf("could not look up %s - %w", name, err)
+	}
+	return matchupSide{Name: pokemon.Name, Pokemon: true, Types: pokemonTypes(pokemon)}, nil
+}
+
+// damageFrom returns the multipliers of the attacking types that are not
+// normally effective against type t.
+func damageFrom(t globals.Type) map[string]float64 {
+	multipliers := map[string]float64{}
+	for _, relation := range t.DamageRelations.DoubleDamageFrom {
+		multipliers[relation.Name] = 2
+	}
+	for _, relation := range t.DamageRelations.HalfDamageFrom {
+		multipliers[relation.Name] = 0.5
+	}
+	for _, relation := range t.DamageRelations.NoDamageFrom {
+		multipliers[relation.Name] = 0
+	}
+	return multipliers
+}
+
+// formatMultiplier formats a damage multiplier, e.g. 2x or 0.25x.
+func formatMultiplier(multiplier float64) string {
+	return strconv.FormatFloat(multiplier, 'g', -1, 64) + "x"
+}
+
+// describeSide names a side with its types, e.g. "bulbasaur (grass/poison)".
+func describeSide(side matchupSide, th theme.Theme, msg i18n.Printer) string {
+	types := make([]string, 0, len(side.Types))
+	for _, typeName := range side.Types {
+		types = append(types, th.Type(typeName, typeName))
+	}
+	if !side.Pokemon {
+		return msg.Sprintf("%s type", strings.Join(types, "/"))
+	}
+	return fmt.Sprintf("%s (%s)", th.Heading(side.Name), strings.Join(types, "/"))
+}
+
+type defensiveMatchupResult struct {
+	msg         i18n.Printer
+	Defender    matchupSide      `json:"defender"`
+	Weaknesses  []typeMultiplier `json:"weaknesses"`
+	Resistances []typeMultiplier `json:"resistances"`
+	Immunities  []string         `json:"immunities"`
+}
+
+func (r defensiveMatchupResult) renderText(w io.Writer, th theme.Theme) {
+	defer fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, r.msg.Sprintf("Matchups of %s:", describeSide(r.Defender, th, r.msg)))
+
+	fmt.Fprintln(w, r.msg.Sprintf("Weak to:"))
+	for _, weakness := range r.Weaknesses {
+		fmt.Fprintf(w, "  - %s %s\n", th.Type(weakness.Type, fmt.Sprintf("%-10s", weakness.Type)), th.Failure(formatMultiplier(weakness.Multiplier)))
+	}
+	fmt.Fprintln(w, r.msg.Sprintf("Resists:"))
+	for _, resistance := range r.Resistances {
+		fmt.Fprintf(w, "  - %s %s\n", th.Type(resistance.Type, fmt.Sprintf("%-10s", resistance.Type)), th.Success(formatMultiplier(resistance.Multiplier)))
+	}
+	fmt.Fprintln(w, r.msg.Sprintf("Immune to:"))
+	for _, immunity := range r.Immunities {
+		fmt.Fprintf(w, "  - %s\n", th.Type(immunity, immunity))
+	}
+}
+
+type versusMatchupResult struct {
+	msg      i18n.Printer
+	Attacker matchupSide      `json:"attacker"`
+	Defender matchupSide      `json:"defender"`
+	Attacks  []typeMultiplier `json:"attacks"`
+}
+
+func (r versusMatchupResult) renderText(w io.Writer, th theme.Theme) {
+	defer fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, r.msg.Sprintf("%s vs %s:", describeSide(r.Attacker, th, r.msg), describeSide(r.Defender, th, r.msg)))
+	for _, attack := range r.Attacks {
+		effect := r.msg.Sprintf("normal damage")
+		switch {
+		case attack.Multiplier == 0:
+			effect = th.Failure(r.msg.Sprintf("no effect"))
+		case attack.Multiplier > 1:
+			effect = th.Success(r.msg.Sprintf("super effective"))
+		case attack.Multiplier < 1:
+			effect = th.Failure(r.msg.Sprintf("not very effective"))
+		}
+		fmt.Fprintf(w, "  - %s %s %s\n", r.msg.Sprintf("%s moves:", th.Type(attack.Type, attack.Type)), formatMultiplier(attack.Multiplier), effect)
+	}
+}
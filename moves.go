@@ -0,0 +1,240 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/acehotel33/pokedex-cli/globals"
+	"github.com/acehotel33/pokedex-cli/internal/api"
+	"github.com/acehotel33/pokedex-cli/internal/i18n"
+	"github.com/acehotel33/pokedex-cli/internal/theme"
+)
+
+// learnsetEntry is one way a pokemon learns a move, merged over the version
+// groups where it is learned the same way.
+type learnsetEntry struct {
+	Move          string   `json:"move"`
+	Method        string   `json:"method"`
+	Level         int      `json:"level"`
+	VersionGroups []string `json:"version_groups"`
+}
+
+// methodOrder sorts the learn methods, with unknown methods last.
+var methodOrder = map[string]int{"level-up": 1, "machine": 2, "egg": 3, "tutor": 4}
+
+func commandMoves(conf *globals.Config, params []string) error {
+	fs := flag.NewFlagSet("moves", flag.ContinueOnError)
+	versionGroup := fs.String("version-group", "", "only show moves learned in this version group, e.g. red-blue")
+	method := fs.String("method", "", "only show moves learned this way, e.g. level-up or machine")
+	args, err := parseFlags(fs, params)
+	if err != nil {
+		return err
+	}
+	if len(args) < 1 {
+		return i18n.Errorf("missing pokemon")
+	}
+
+	pokemon, err := api.GetPokemon(conf.Endpoint("pokemon", args[0]), conf)
+	if err != nil {
+		return i18n.Errorf("could not find pokemon - %w", err)
+	}
+
+	res := learnsetResult{
+		msg:          i18n.New(conf.Settings.Lang),
+		Pokemon:      pokemon.Name,
+		VersionGroup: *versionGroup,
+		Method:       *method,
+		Moves:        learnset(pokemon, *versionGroup, *method),
+	}
+	moves := make([]string, 0, len(res.Moves))
+	for _, entry := range res.Moves {
+		moves = append(moves, entry.Move)
+	}
+	names := newNameLoader(conf, conf.Settings.Lang)
+	names.load("move", moves)
+	res.Names, res.Warning = names.names, names.warning()
+	return render(conf, res)
+}
+
+// learnset lists the moves of pokemon sorted by method and level, only
+// those in versionGroup and learned by method unless they are empty.
+func learnset(pokemon globals.Pokemon, versionGroup, method string) []learnsetEntry {
+	entries := []learnsetEntry{}
+	for _, move := range pokemon.Moves {
+		byWay := map[string]int{}
+		for _, detail := range move.VersionGroupDetails {
+			if versionGroup != "" && detail.VersionGroup.Name != versionGroup {
+				continue
+			}
+			if method != "" && detail.MoveLearnMethod.Name != method {
+				continue
+			}
+			way := detail.MoveLearnMethod.Name + "/" + strconv.Itoa(detail.LevelLearnedAt)
+			i, ok := byWay[way]
+			if !ok {
+				i = len(entries)
+				byWay[way] = i
+				entries = append(entries, learnsetEntry{
+					Move:   move.Move.Name,
+					Method: detail.MoveLearnMethod.Name,
+					Level:  detail.LevelLearnedAt,
+				})
+			}
+			entries[i].VersionGroups = append(entries[i].VersionGroups, detail.VersionGroup.Name)
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if methodRank(a.Method) != methodRank(b.Method) {
+			return methodRank(a.Method) < methodRank(b.Method)
+		}
+		if a.Level != b.Level {
+			return a.Level < b.Level
+		}
+		return a.Move < b.Move
+	})
+	return entries
+}
+
+func methodRank(method string) int {
+	if rank, ok := methodOrder[method]; ok {
+		return rank
+	}
+	return len(methodOrder) + 1
+}
+
+func commandMove(conf *globals.Config, params []string) error {
+	if len(params) < 1 {
+		return i18n.Errorf("missing move")
+	}
+
+	move, err := api.GetMove(conf.Endpoint("move", params[0]), conf)
+	if err != nil {
+		return i18n.Errorf("could not find move - %w", err)
+	}
+	return render(conf, moveResult{
+		msg:         i18n.New(conf.Settings.Lang),
+		Name:        move.Name,
+		DisplayName: displayName(move.Names, conf.Settings.Lang, move.Name),
+		Type:        move.Type.Name,
+		DamageClass: move.DamageClass.Name,
+		Power:       move.Power,
+		Accuracy:    move.Accuracy,
+		PP:          move.PP,
+		Priority:    move.Priority,
+		Effect:      moveEffect(move),
+	})
+}
+
+// moveEffect returns the English short effect of a move, with the chance of
+// its effect filled in if it has one.
+func moveEffect(move globals.Move) string {
+	for _, entry := range move.EffectEntries {
+		if entry.Language.Name != "en" {
+			continue
+		}
+		if move.EffectChance == nil {
+			return entry.ShortEffect
+		}
+		return strings.ReplaceAll(entry.ShortEffect, "$effect_chance", strconv.Itoa(*move.EffectChance))
+	}
+	return ""
+}
+
+type learnsetResult struct {
+	msg          i18n.Printer
+	Names        localNames      `json:"names,omitempty"`
+	Warning      string          `json:"warning,omitempty"`
+	Pokemon      string          `json:"pokemon"`
+	VersionGroup string          `json:"version_group,omitempty"`
+	Method       string          `json:"method,omitempty"`
+	Moves        []learnsetEntry `json:"moves"`
+}
+
+func (r learnsetResult) renderText(w io.Writer, th theme.Theme) {
+	defer fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, ".\n.")
+	if r.VersionGroup != "" {
+		fmt.Fprintln(w, th.Heading(r.msg.Sprintf("Moves of %s in %s:", r.Pokemon, r.VersionGroup)))
+	} else {
+		fmt.Fprintln(w, th.Heading(r.msg.Sprintf("Moves of %s:", r.Pokemon)))
+	}
+	renderNameWarning(w, th, r.msg, r.Warning)
+	if len(r.Moves) == 0 {
+		fmt.Fprintln(w, r.msg.Sprintf("No moves found."))
+		return
+	}
+
+	method := ""
+	for _, entry := range r.Moves {
+		if entry.Method != method {
+			method = entry.Method
+			fmt.Fprintf(w, "%s:\n", method)
+		}
+		level := ""
+		if entry.Method == "level-up" {
+			level = r.msg.Sprintf("lv %d", entry.Level)
+		}
+		versions := ""
+		if r.VersionGroup == "" {
+			versions = th.Muted(describeVersionGroups(entry.VersionGroups, r.msg))
+		}
+		// The colours of a local name would count towards the padding.
+		move := fmt.Sprintf("%-16s", entry.Move)
+		if local, ok := r.Names[entry.Move]; ok && local != entry.Move {
+			move = r.Names.label(entry.Move, th)
+		}
+		line := fmt.Sprintf("  - %-6s %s %s", level, move, versions)
+		fmt.Fprintln(w, strings.TrimRight(line, " "))
+	}
+}
+
+// describeVersionGroups lists a few version groups, or counts many.
+func describeVersionGroups(groups []string, msg i18n.Printer) string {
+	if len(groups) > 3 {
+		return msg.Sprintf("(%d version groups)", len(groups))
+	}
+	return strings.Join(groups, ", ")
+}
+
+type moveResult struct {
+	msg         i18n.Printer
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	Type        string `json:"type"`
+	DamageClass string `json:"damage_class"`
+	Power       int    `json:"power"`
+	Accuracy    int    `json:"accuracy"`
+	PP          int    `json:"pp"`
+	Priority    int    `json:"priority"`
+	Effect      string `json:"effect"`
+}
+
+func (r moveResult) renderText(w io.Writer, th theme.Theme) {
+	defer fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, ".\n.")
+	orDash := func(n int) string {
+		if n == 0 {
+			return "-"
+		}
+		return strconv.Itoa(n)
+	}
+
+	fmt.Fprintf(w, "%s %s\n", th.Heading(r.DisplayName), th.Muted("("+r.Name+")"))
+	fmt.Fprintln(w, r.msg.Sprintf("Type: %s", th.Type(r.Type, r.Type)))
+	fmt.Fprintln(w, r.msg.Sprintf("Category: %s", r.DamageClass))
+	fmt.Fprintln(w, r.msg.Sprintf("Power: %s", orDash(r.Power)))
+	fmt.Fprintln(w, r.msg.Sprintf("Accuracy: %s", orDash(r.Accuracy)))
+	fmt.Fprintln(w, r.msg.Sprintf("PP: %d", r.PP))
+	if r.Priority != 0 {
+		fmt.Fprintln(w, r.msg.Sprintf("Priority: %+d", r.Priority))
+	}
+	if r.Effect != "" {
+		fmt.Fprintln(w, r.msg.Sprintf("Effect: %s", r.Effect))
+	}
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/acehotel33/pokedex-cli/globals"
+	"github.com/acehotel33/pokedex-cli/internal/api"
+	"github.com/acehotel33/pokedex-cli/internal/i18n"
+	"github.com/acehotel33/pokedex-cli/internal/theme"
+)
+
+func commandGoto(conf *globals.Config, params []string) error {
+	if len(params) < 1 {
+		return i18n.Errorf("missing area - use 'areas <location>' to find one")
+	}
+
+	area, err := api.GetArea(conf.Endpoint("location-area", params[0]), conf)
+	if err != nil {
+		return i18n.Errorf("could not travel to %s - %w", params[0], err)
+	}
+	location, err := api.GetLocation(area.Location.URL, conf)
+	if err != nil {
+		return i18n.Errorf("could not travel to %s - %w", params[0], err)
+	}
+
+	conf.Position = globals.Position{
+		Region:   location.Region.Name,
+		Location: location.Name,
+		Area:     area.Name,
+	}
+	// Any wild pokemon met in the old area is left behind.
+	conf.Encounter = nil
+	if err := saveGame(conf); err != nil {
+		return err
+	}
+	return render(conf, positionResult{msg: i18n.New(conf.Settings.Lang), Travelled: true, Position: conf.Position})
+}
+
+func commandWhere(conf *globals.Config, params []string) error {
+	if len(params) > 0 {
+		return commandWherePokemon(conf, params)
+	}
+	return render(conf, positionResult{msg: i18n.New(conf.Settings.Lang), Position: conf.Position})
+}
+
+func commandRegions(conf *globals.Config, params []string) error {
+	regions, err := api.GetResourceList(conf.Endpoint("region", ""), conf)
+	if err != nil {
+		return i18n.Errorf("could not list regions - %w", err)
+	}
+	return render(conf, browseResult{
+		msg:      i18n.New(conf.Settings.Lang),
+		Scope:    "regions",
+		Children: resourceNames(regions),
+		current:  conf.Position.Region,
+	})
+}
+
+func commandLocations(conf *globals.Config, params []string) error {
+	regionName := conf.Position.Region
+	if len(params) > 0 {
+		regionName = params[0]
+	}
+	if regionName == "" {
+		return i18n.Errorf("missing region - use 'regions' to list them")
+	}
+
+	region, err := api.GetRegion(conf.Endpoint("region", regionName), conf)
+	if err != nil {
+		return i18n.Errorf("could not list locations - %w", err)
+	}
+	return render(conf, browseResult{
+		msg:      i18n.New(conf.Settings.Lang),
+		Scope:    "locations",
+		Name:     region.Name,
+		Children: resourceNames(region.Locations),
+		current:  conf.Position.Location,
+	})
+}
+
+func commandAreas(conf *globals.Config, params []string) error {
+	locationName := conf.Position.Location
+	if len(params) > 0 {
+		locationName = params[0]
+	}
+	if locationName == "" {
+		return i18n.Errorf("missing location - use 'locations <region>' to list them")
+	}
+
+	location, err := api.GetLocation(conf.Endpoint("location", locationName), conf)
+	if err != nil {
+		return i18n.Errorf("could not list areas - %w", err)
+	}
+	return render(conf, browseResult{
+		msg:      i18n.New(conf.Settings.Lang),
+		Scope:    "areas",
+		Name:     location.Name,
+		Children: resourceNames(location.Areas),
+		current:  conf.Position.Area,
+	})
+}
+
+func resourceNames(resources []globals.NamedResource) []string {
+	names := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		names = append(names, resource.Name)
+	}
+	return names
+}
+
+type positionResult struct {
+	msg       i18n.Printer
+	Travelled bool             `json:"travelled"`
+	Position  globals.Position `json:"position"`
+}
+
+func (r positionResult) renderText(w io.Writer, th theme.Theme) {
+	defer fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, ".\n.")
+	if r.Position.Area == "" {
+		fmt.Fprintln(w, r.msg.Sprintf("You haven't travelled anywhere yet. Use 'goto <area>' to set out."))
+		return
+	}
+	if r.Travelled {
+		fmt.Fprintln(w, r.msg.Sprintf("Travelled to %s!", th.Heading(r.Position.Area)))
+	} else {
+		fmt.Fprintln(w, r.msg.Sprintf("You are in %s.", th.Heading(r.Position.Area)))
+	}
+	fmt.Fprintln(w, r.msg.Sprintf("Region: %s", r.Position.Region))
+	fmt.Fprintln(w, r.msg.Sprintf("Location: %s", r.Position.Location))
+}
+
+// browseTitles are the headings of the lists of regions, of the locations
+// in a region and of the areas in a location, and browseEmpty what is shown
+// when there are none.
+var (
+	browseTitles = map[string]string{
+		"regions":   "Regions:",
+		"locations": "Locations in %s:",
+		"areas":     "Areas in %s:",
+	}
+	browseEmpty = map[string]string{
+		"regions":   "No regions found.",
+		"locations": "No locations found.",
+		"areas":     "No areas found.",
+	}
+)
+
+type browseResult struct {
+	msg      i18n.Printer
+	current  string
+	Scope    string   `json:"scope"`
+	Name     string   `json:"name,omitempty"`
+	Children []string `json:"children"`
+}
+
+func (r browseResult) renderText(w io.Writer, th theme.Theme) {
+	defer fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, ".\n.")
+	if r.Name != "" {
+		fmt.Fprintln(w, th.Heading(r.msg.Sprintf(browseTitles[r.Scope], r.Name)))
+	} else {
+		fmt.Fprintln(w, th.Heading(r.msg.Sprintf(browseTitles[r.Scope])))
+	}
+	if len(r.Children) == 0 {
+		fmt.Fprintln(w, r.msg.Sprintf(browseEmpty[r.Scope]))
+	}
+	for _, child := range r.Children {
+		if child == r.current {
+			fmt.Fprintf(w, "* %s %s\n", th.Success(child), th.Muted(r.msg.Sprintf("(you are here)")))
+		} else {
+			fmt.Fprintf(w, "- %s\n", child)
+		}
+	}
+}
@@ -0,0 +1,329 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/acehotel33/pokedex-cli/globals"
+	"github.com/acehotel33/pokedex-cli/internal/animation"
+	"github.com/acehotel33/pokedex-cli/internal/i18n"
+	"github.com/acehotel33/pokedex-cli/internal/theme"
+)
+
+// result is implemented by the value every command produces, so that the
+// same result can be rendered either as human text or as a JSON document.
+type result interface {
+	renderText(w io.Writer, th theme.Theme)
+}
+
+func render(conf *globals.Config, res result) error {
+	if conf.Output == globals.OutputJSON {
+		data, err := json.Marshal(res)
+		if err != nil {
+			return i18n.Errorf("could not encode result as json - %w", err)
+		}
+		fmt.Fprintln(conf.Out, string(data))
+		return nil
+	}
+	res.renderText(conf.Out, conf.Theme)
+	return nil
+}
+
+func renderError(w io.Writer, conf *globals.Config, err error) {
+	if conf.Output == globals.OutputJSON {
+		data, _ := json.Marshal(struct {
+			Error string `json:"error"`
+		}{Error: err.Error()})
+		fmt.Fprintln(w, string(data))
+		return
+	}
+	msg := i18n.New(conf.Settings.Lang)
+	fmt.Fprintln(w, msg.Sprintf("Could not perform command: %v", msg.Error(err)))
+}
+
+// renderNameWarning shows which local names could not be looked up, if any.
+func renderNameWarning(w io.Writer, th theme.Theme, msg i18n.Printer, warning string) {
+	if warning != "" {
+		fmt.Fprintln(w, th.Failure(msg.Sprintf("Some names could not be translated: %s", warning)))
+	}
+}
+
+type messageResult struct {
+	msg     i18n.Printer
+	Message string `json:"message"`
+}
+
+func (r messageResult) renderText(w io.Writer, th theme.Theme) {
+	defer fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, r.msg.Sprintf(r.Message))
+}
+
+type seedResult struct {
+	msg  i18n.Printer
+	Seed int64 `json:"seed"`
+}
+
+func (r seedResult) renderText(w io.Writer, th theme.Theme) {
+	defer fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, r.msg.Sprintf("Random seed: %d", r.Seed))
+}
+
+type helpEntry struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type helpResult struct {
+	msg      i18n.Printer
+	Commands []helpEntry `json:"commands"`
+}
+
+func (r helpResult) renderText(w io.Writer, th theme.Theme) {
+	defer fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, r.msg.Sprintf("Welcome to the Pokedex!"))
+	fmt.Fprintln(w, r.msg.Sprintf("Usage:"))
+	fmt.Fprintln(w)
+
+	for _, cmd := range r.Commands {
+		fmt.Fprintf(w, "%s: %s\n", cmd.Name, cmd.Description)
+	}
+}
+
+type mapResult struct {
+	msg         i18n.Printer
+	Names       localNames             `json:"names,omitempty"`
+	Warning     string                 `json:"warning,omitempty"`
+	Locations   []globals.LocationArea `json:"locations"`
+	Page        int                    `json:"page"`
+	Pages       int                    `json:"pages"`
+	Count       int                    `json:"count"`
+	NextURL     string                 `json:"next"`
+	PreviousURL string                 `json:"previous"`
+}
+
+func (r mapResult) renderText(w io.Writer, th theme.Theme) {
+	defer fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, ".\n.")
+	for _, location := range r.Locations {
+		fmt.Fprintln(w, r.Names.label(location.Name, th))
+	}
+	fmt.Fprintln(w, th.Muted(r.msg.Sprintf("page %d of %d", r.Page, r.Pages)))
+	renderNameWarning(w, th, r.msg, r.Warning)
+}
+
+type exploreResult struct {
+	compact     bool
+	msg         i18n.Printer
+	Names       localNames          `json:"names,omitempty"`
+	Warning     string              `json:"warning,omitempty"`
+	Location    string              `json:"location"`
+	Version     string              `json:"version,omitempty"`
+	Pokemon     []string            `json:"pokemon"`
+	Encounters  []pokemonEncounters `json:"encounters,omitempty"`
+	MethodRates []methodRate        `json:"method_rates,omitempty"`
+}
+
+func (r exploreResult) renderText(w io.Writer, th theme.Theme) {
+	defer fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, r.msg.Sprintf("Exploring %s...", r.Names.label(r.Location, th)))
+	renderNameWarning(w, th, r.msg, r.Warning)
+	if r.Version != "" {
+		fmt.Fprintln(w, r.msg.Sprintf("Version: %s", r.Version))
+	}
+	fmt.Fprintln(w, r.msg.Sprintf("Found Pokemon:"))
+	if r.compact {
+		for _, pokemon := range r.Pokemon {
+			fmt.Fprintf(w, "- %s\n", r.Names.label(pokemon, th))
+		}
+		return
+	}
+
+	for _, pokemon := range r.Encounters {
+		fmt.Fprintf(w, "- %s\n", r.Names.label(pokemon.Pokemon, th))
+		for _, encounter := range pokemon.Encounters {
+			fmt.Fprintf(w, "    %-12s %-9s %3d%%  %s\n",
+				encounter.Method,
+				levelRange(encounter.MinLevel, encounter.MaxLevel, r.msg),
+				encounter.Chance,
+				th.Muted(strings.Join(encounter.Versions, ", ")))
+		}
+	}
+	if len(r.MethodRates) > 0 {
+		fmt.Fprintln(w, th.Heading(r.msg.Sprintf("Encounter rates:")))
+		for _, rate := range r.MethodRates {
+			fmt.Fprintf(w, "    %-12s %3d%%\n", rate.Method, rate.Rate)
+		}
+	}
+}
+
+type catchResult struct {
+	animation   animation.Player
+	msg         i18n.Printer
+	pokemonType string
+	Pokemon     string  `json:"pokemon"`
+	Form        string  `json:"form,omitempty"`
+	Shiny       bool    `json:"shiny"`
+	CaptureRate int     `json:"capture_rate"`
+	Ball        string  `json:"ball"`
+	BallsLeft   int     `json:"balls_left"`
+	Chance      float64 `json:"chance"`
+	Shakes      int     `json:"shakes"`
+	// Threshold and Rolls are the shake checks: each roll in [0, 65536)
+	// passes when it is below the threshold.
+	Threshold int           `json:"threshold"`
+	Rolls     []int         `json:"rolls"`
+	Caught    bool          `json:"caught"`
+	Pokedex   pokedexResult `json:"pokedex"`
+}
+
+func (r catchResult) renderText(w io.Writer, th theme.Theme) {
+	fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, r.msg.Sprintf("Capture rate of %s: %v", r.Pokemon, r.CaptureRate))
+	fmt.Fprintln(w, r.msg.Sprintf("Chance of success: %.1f percent", r.Chance))
+
+	r.animation.Pause(2)
+	fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, r.msg.Sprintf("Throwing a %s at %s...", r.Ball, r.Pokemon))
+	// One dot per shake of the ball before it either clicks or breaks open.
+	for i := 0; i < r.Shakes; i++ {
+		r.animation.Shake(".")
+	}
+	r.animation.Pause(1)
+
+	name := th.Type(r.pokemonType, r.Pokemon)
+	if r.Form != "" {
+		name += " (" + r.Form + ")"
+	}
+	if r.Caught {
+		fmt.Fprintln(w, r.msg.Sprintf("Result: %s You caught %v!", th.Success(r.msg.Sprintf("Success!")), name))
+		if r.Shiny {
+			fmt.Fprintln(w, r.msg.Sprintf("%s It's a shiny %s!", th.Success(shinyMark), r.Pokemon))
+		}
+	} else {
+		fmt.Fprintln(w, r.msg.Sprintf("Result: %s %v slipped away!", th.Failure(r.msg.Sprintf("Oh no!")), name))
+	}
+
+	fmt.Fprintln(w, r.msg.Sprintf("%s left: %d", r.Ball, r.BallsLeft))
+
+	r.animation.Pause(1)
+	fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, r.msg.Sprintf("Current Pokedex:"))
+	r.Pokedex.renderText(w, th)
+}
+
+// shinyMark is shown next to shiny pokemon.
+const shinyMark = "★"
+
+type pokedexResult struct {
+	types   map[string]string
+	msg     i18n.Printer
+	Names   localNames `json:"names,omitempty"`
+	Warning string     `json:"warning,omitempty"`
+	Pokemon []string   `json:"pokemon"`
+	Shiny   []string   `json:"shiny,omitempty"`
+}
+
+func newPokedexResult(conf *globals.Config) pokedexResult {
+	res := pokedexResult{types: map[string]string{}, msg: i18n.New(conf.Settings.Lang)}
+	for _, name := range sortedKeys(conf.Pokedex) {
+		res.Pokemon = append(res.Pokemon, name)
+		res.types[name] = primaryType(conf.Pokedex[name])
+		if conf.Pokedex[name].Shiny {
+			res.Shiny = append(res.Shiny, name)
+		}
+	}
+	names := newNameLoader(conf, conf.Settings.Lang)
+	names.load("pokemon-species", res.Pokemon)
+	res.Names, res.Warning = names.names, names.warning()
+	return res
+}
+
+func (r pokedexResult) renderText(w io.Writer, th theme.Theme) {
+	fmt.Fprintln(w, ".\n.")
+	if len(r.Pokemon) == 0 {
+		fmt.Fprintln(w, r.msg.Sprintf("Pokedex is empty!"))
+		return
+	}
+	for _, name := range r.Pokemon {
+		label := th.Type(r.types[name], r.Names.label(name, th))
+		if slices.Contains(r.Shiny, name) {
+			label += " " + th.Success(shinyMark)
+		}
+		fmt.Fprintf(w, "- %s -\n", label)
+	}
+	renderNameWarning(w, th, r.msg, r.Warning)
+}
+
+// maxBaseStat is the highest value a base stat can take, used to scale the
+// stat bars.
+const maxBaseStat = 255
+
+type inspectResult struct {
+	msg       i18n.Printer
+	sprite    []string
+	Names     localNames      `json:"names,omitempty"`
+	Warning   string          `json:"warning,omitempty"`
+	Pokemon   globals.Pokemon `json:"pokemon"`
+	Species   *speciesInfo    `json:"species,omitempty"`
+	SpriteURL string          `json:"sprite,omitempty"`
+}
+
+func (r inspectResult) renderText(w io.Writer, th theme.Theme) {
+	poke := r.Pokemon
+	fmt.Fprintln(w, ".\n.")
+	for _, line := range r.sprite {
+		fmt.Fprintln(w, line)
+	}
+	name := th.Type(primaryType(poke), r.Names.label(poke.Name, th))
+	if poke.Shiny {
+		name += " " + th.Success(shinyMark)
+	}
+	fmt.Fprintln(w, r.msg.Sprintf("Name: %s", name))
+	renderNameWarning(w, th, r.msg, r.Warning)
+	if poke.Form != "" {
+		fmt.Fprintln(w, r.msg.Sprintf("Form: %s", poke.Form))
+	}
+	if r.Species != nil && r.Species.Genus != "" {
+		fmt.Fprintln(w, r.msg.Sprintf("Genus: %s", r.Species.Genus))
+	}
+	if poke.Level > 0 {
+		fmt.Fprintln(w, r.msg.Sprintf("Level: %v", poke.Level))
+	}
+	fmt.Fprintln(w, r.msg.Sprintf("Height: %v", poke.Height))
+	fmt.Fprintln(w, r.msg.Sprintf("Weight: %v", poke.Weight))
+
+	fmt.Fprintln(w, th.Heading(r.msg.Sprintf("Stats:")))
+	for _, stat := range poke.Stats {
+		fmt.Fprintf(w, "  -%-16s %3v %s\n", stat.Stat.Name+":", stat.BaseStat, th.Bar(stat.BaseStat, maxBaseStat, 20))
+	}
+
+	fmt.Fprintln(w, th.Heading(r.msg.Sprintf("Types:")))
+	for _, pType := range poke.Types {
+		fmt.Fprintf(w, "  - %s\n", th.Type(pType.Type.Name, r.Names.label(pType.Type.Name, th)))
+	}
+
+	abilities := slices.Clone(poke.Abilities)
+	sort.SliceStable(abilities, func(i, j int) bool {
+		return abilities[i].Slot < abilities[j].Slot
+	})
+	fmt.Fprintln(w, th.Heading(r.msg.Sprintf("Abilities:")))
+	for _, ability := range abilities {
+		if ability.IsHidden {
+			fmt.Fprintf(w, "  - %s %s\n", r.Names.label(ability.Ability.Name, th), th.Muted(r.msg.Sprintf("(hidden)")))
+		} else {
+			fmt.Fprintf(w, "  - %s\n", r.Names.label(ability.Ability.Name, th))
+		}
+	}
+	if r.Species != nil {
+		r.Species.renderText(w, th, r.msg)
+	}
+	fmt.Fprintln(w, ".\n.")
+}
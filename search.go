@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/acehotel33/pokedex-cli/globals"
+	"github.com/acehotel33/pokedex-cli/internal/api"
+	"github.com/acehotel33/pokedex-cli/internal/fuzzy"
+	"github.com/acehotel33/pokedex-cli/internal/i18n"
+	"github.com/acehotel33/pokedex-cli/internal/theme"
+)
+
+// indexPageSize is the page size used to fetch the full location-area index
+// in as few requests as possible.
+const indexPageSize = 1000
+
+func commandMapSearch(conf *globals.Config, words []string) error {
+	if len(words) < 1 {
+		return i18n.Errorf("missing search term")
+	}
+	// Area names are hyphenated, so "canalave city" finds canalave-city-area.
+	query := strings.Join(words, "-")
+
+	if conf.AreaIndex == nil {
+		url := fmt.Sprintf("%s?offset=0&limit=%d", conf.Endpoint("location-area", ""), indexPageSize)
+		index, err := api.GetResourceList(url, conf)
+		if err != nil {
+			return i18n.Errorf("could not fetch location area index - %w", err)
+		}
+		conf.AreaIndex = index
+	}
+
+	byName := map[string]globals.NamedResource{}
+	names := make([]string, 0, len(conf.AreaIndex))
+	for _, area := range conf.AreaIndex {
+		byName[area.Name] = area
+		names = append(names, area.Name)
+	}
+
+	// Short queries match much of the index, so only the best page of
+	// matches is shown.
+	found := fuzzy.Filter(query, names)
+	res := searchResult{msg: i18n.New(conf.Settings.Lang), Query: query, Matches: []searchMatch{}, Total: len(found)}
+	for _, name := range found[:min(len(found), conf.MapLimit)] {
+		res.Matches = append(res.Matches, searchMatch{ID: byName[name].ID(), Name: name})
+	}
+	return render(conf, res)
+}
+
+type searchMatch struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type searchResult struct {
+	msg     i18n.Printer
+	Query   string        `json:"query"`
+	Matches []searchMatch `json:"matches"`
+	// Total counts every match, including those beyond the page size.
+	Total int `json:"total"`
+}
+
+func (r searchResult) renderText(w io.Writer, th theme.Theme) {
+	defer fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, ".\n.")
+	if len(r.Matches) == 0 {
+		fmt.Fprintln(w, r.msg.Sprintf("No location areas match %q.", r.Query))
+		return
+	}
+	for _, match := range r.Matches {
+		fmt.Fprintf(w, "%6d  %s\n", match.ID, match.Name)
+	}
+	if r.Total == 1 {
+		fmt.Fprintln(w, th.Muted(r.msg.Sprintf("1 match - explore an area by name or id")))
+	} else {
+		fmt.Fprintln(w, th.Muted(r.msg.Sprintf("%d matches - explore an area by name or id", r.Total)))
+	}
+	if hidden := r.Total - len(r.Matches); hidden > 0 {
+		fmt.Fprintln(w, th.Muted(r.msg.Sprintf("%d more not shown - narrow the search or raise page_size", hidden)))
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/acehotel33/pokedex-cli/globals"
+	"github.com/acehotel33/pokedex-cli/internal/i18n"
+	"github.com/acehotel33/pokedex-cli/internal/theme"
+)
+
+// speciesInfo is what inspect shows of a pokemon's species.
+type speciesInfo struct {
+	Genus string `json:"genus"`
+	// FlavorText is the pokedex entry of FlavorVersion.
+	FlavorText    string `json:"flavor_text"`
+	FlavorVersion string `json:"flavor_version"`
+	Habitat       string `json:"habitat"`
+	Color         string `json:"color"`
+	Shape         string `json:"shape"`
+	Generation    string `json:"generation"`
+	Legendary     bool   `json:"legendary"`
+	Mythical      bool   `json:"mythical"`
+	// GenderRate is the chance of being female in eighths, or -1 for
+	// genderless species.
+	GenderRate int `json:"gender_rate"`
+}
+
+// newSpeciesInfo picks the genus and pokedex entry in lang, falling back to
+// English. The entry is the one of version, or the latest if version is "".
+func newSpeciesInfo(species globals.Species, version, lang string) speciesInfo {
+	info := speciesInfo{
+		Habitat:    species.Habitat.Name,
+		Color:      species.Color.Name,
+		Shape:      species.Shape.Name,
+		Generation: species.Generation.Name,
+		Legendary:  species.IsLegendary,
+		Mythical:   species.IsMythical,
+		GenderRate: species.GenderRate,
+	}
+
+	for _, language := range []string{lang, "en"} {
+		for _, genus := range species.Genera {
+			if info.Genus == "" && genus.Language.Name == language {
+				info.Genus = genus.Genus
+			}
+		}
+		for _, entry := range species.FlavorTextEntries {
+			if entry.Language.Name != language || (version != "" && entry.Version.Name != version) {
+				continue
+			}
+			// Later entries are from newer games.
+			info.FlavorText, info.FlavorVersion = cleanFlavorText(entry.FlavorText), entry.Version.Name
+		}
+		if info.FlavorText != "" {
+			break
+		}
+	}
+	return info
+}
+
+// cleanFlavorText joins the lines of a pokedex entry, which are broken up
+// the way the games display them.
+func cleanFlavorText(text string) string {
+	text = strings.NewReplacer("\u00ad\n", "", "\f", " ", "\n", " ").Replace(text)
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// genderRatio describes a gender rate, e.g. "50% female, 50% male".
+func genderRatio(rate int, msg i18n.Printer) string {
+	if rate < 0 {
+		return msg.Sprintf("genderless")
+	}
+	female := float64(rate) / 8 * 100
+	return msg.Sprintf("%g%% female, %g%% male", female, 100-female)
+}
+
+// renderText writes the species part of inspect.
+func (s speciesInfo) renderText(w io.Writer, th theme.Theme, msg i18n.Printer) {
+	if s.FlavorText != "" {
+		fmt.Fprintln(w, th.Heading(msg.Sprintf("Pokedex entry (%s):", s.FlavorVersion)))
+		fmt.Fprintf(w, "  %s\n", s.FlavorText)
+	}
+	if s.Habitat != "" {
+		fmt.Fprintln(w, msg.Sprintf("Habitat: %s", s.Habitat))
+	}
+	fmt.Fprintln(w, msg.Sprintf("Color: %s", s.Color))
+	fmt.Fprintln(w, msg.Sprintf("Shape: %s", s.Shape))
+	fmt.Fprintln(w, msg.Sprintf("Generation: %s", s.Generation))
+	fmt.Fprintln(w, msg.Sprintf("Gender: %s", genderRatio(s.GenderRate, msg)))
+	if s.Legendary {
+		fmt.Fprintln(w, th.Heading(msg.Sprintf("Legendary Pokemon")))
+	}
+	if s.Mythical {
+		fmt.Fprintln(w, th.Heading(msg.Sprintf("Mythical Pokemon")))
+	}
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/acehotel33/pokedex-cli/globals"
+	"github.com/acehotel33/pokedex-cli/internal/api"
+	"github.com/acehotel33/pokedex-cli/internal/i18n"
+	"github.com/acehotel33/pokedex-cli/internal/sprite"
+	"github.com/acehotel33/pokedex-cli/internal/theme"
+)
+
+// maxSpriteWidth is how many columns a sprite is scaled down to at most.
+const maxSpriteWidth = 48
+
+// spriteGenerations lists the generations sprites can be picked from.
+var spriteGenerations = []string{"i", "ii", "iii", "iv", "v", "vi", "vii", "viii"}
+
+func commandSprite(conf *globals.Config, params []string) error {
+	fs := flag.NewFlagSet("sprite", flag.ContinueOnError)
+	shiny := fs.Bool("shiny", false, "show the shiny colouring")
+	gen := fs.String("gen", "", "show the sprite of a generation, e.g. iii")
+	back := fs.Bool("back", false, "show the pokemon from behind")
+	form := fs.String("form", "", "show a form of the pokemon, e.g. unown-b; defaults to the form caught")
+	args, err := parseFlags(fs, params)
+	if err != nil {
+		return err
+	}
+	if len(args) < 1 {
+		return i18n.Errorf("missing pokemon")
+	}
+
+	pokemon, err := api.GetPokemon(conf.Endpoint("pokemon", args[0]), conf)
+	if err != nil {
+		return i18n.Errorf("could not find pokemon - %w", err)
+	}
+	if caught, exists := conf.Pokedex[pokemon.Name]; exists && *form == "" {
+		*form = caught.Form
+	}
+
+	var sprites spriteSet
+	if *form != "" {
+		if *gen != "" {
+			return i18n.Errorf("forms only have sprites from the latest games")
+		}
+		sprites, err = formSprites(conf, *form)
+	} else {
+		sprites, err = pokemonSprites(pokemon, strings.ToLower(*gen))
+	}
+	if err != nil {
+		return err
+	}
+	url := sprites.pick(*shiny, *back)
+	if url == "" && *gen != "" {
+		return i18n.Errorf("%s has no such sprite in generation %s", pokemon.Name, *gen)
+	}
+	if url == "" {
+		return i18n.Errorf("%s has no such sprite", pokemon.Name)
+	}
+	res := spriteResult{
+		Pokemon: pokemon.Name,
+		Gen:     *gen,
+		Form:    *form,
+		Shiny:   *shiny,
+		Back:    *back,
+		URL:     url,
+	}
+	// JSON output only has the URL, so the sprite isn't downloaded.
+	if conf.Output == globals.OutputJSON {
+		return render(conf, res)
+	}
+	mode := conf.Settings.Sprite
+	if mode == sprite.Off {
+		mode = sprite.Auto
+	}
+	if res.lines, err = loadSprite(conf, url, mode); err != nil {
+		return err
+	}
+	return render(conf, res)
+}
+
+// spriteSet holds the URLs of a pokemon's sprites in one game, empty where
+// the game has none.
+type spriteSet struct {
+	front, back, frontShiny, backShiny string
+}
+
+// pick returns the sprite facing front or back, shiny or not.
+func (s spriteSet) pick(shiny, back bool) string {
+	switch {
+	case back && shiny:
+		return s.backShiny
+	case back:
+		return s.back
+	case shiny:
+		return s.frontShiny
+	}
+	return s.front
+}
+
+// pokemonSprites returns the sprites of pokemon from generation gen, or the
+// latest games if gen is empty.
+func pokemonSprites(pokemon globals.Pokemon, gen string) (spriteSet, error) {
+	var s spriteSet
+	v := pokemon.Sprites.Versions
+	switch gen {
+	case "":
+		s = spriteSet{pokemon.Sprites.FrontDefault, pokemon.Sprites.BackDefault, pokemon.Sprites.FrontShiny, pokemon.Sprites.BackShiny}
+	case "i":
+		s = spriteSet{front: v.GenerationI.RedBlue.FrontDefault, back: v.GenerationI.RedBlue.BackDefault}
+	case "ii":
+		g := v.GenerationIi.Crystal
+		s = spriteSet{g.FrontDefault, g.BackDefault, g.FrontShiny, g.BackShiny}
+	case "iii":
+		g := v.GenerationIii.FireredLeafgreen
+		s = spriteSet{g.FrontDefault, g.BackDefault, g.FrontShiny, g.BackShiny}
+	case "iv":
+		g := v.GenerationIv.Platinum
+		s = spriteSet{g.FrontDefault, g.BackDefault, g.FrontShiny, g.BackShiny}
+	case "v":
+		g := v.GenerationV.BlackWhite
+		s = spriteSet{g.FrontDefault, g.BackDefault, g.FrontShiny, g.BackShiny}
+	case "vi":
+		g := v.GenerationVi.XY
+		s = spriteSet{front: g.FrontDefault, frontShiny: g.FrontShiny}
+	case "vii":
+		g := v.GenerationVii.UltraSunUltraMoon
+		s = spriteSet{front: g.FrontDefault, frontShiny: g.FrontShiny}
+	case "viii":
+		s = spriteSet{front: v.GenerationViii.Icons.FrontDefault}
+	default:
+		return spriteSet{}, i18n.Errorf("unknown generation %q - expected one of %s", gen, strings.Join(spriteGenerations, ", "))
+	}
+	return s, nil
+}
+
+// formSprites returns the sprites of the named pokemon form, e.g. unown-b.
+func formSprites(conf *globals.Config, form string) (spriteSet, error) {
+	pokemonForm, err := api.GetPokemonForm(conf.Endpoint("pokemon-form", form), conf)
+	if err != nil {
+		return spriteSet{}, i18n.Errorf("could not look up form %s - %w", form, err)
+	}
+	s := pokemonForm.Sprites
+	return spriteSet{s.FrontDefault, s.BackDefault, s.FrontShiny, s.BackShiny}, nil
+}
+
+// caughtSprites returns the latest sprites of a caught pokemon, those of
+// its form if it was caught in one. A form that can't be looked up falls
+// back to the pokemon's own sprites.
+func caughtSprites(conf *globals.Config, pokemon globals.Pokemon) (spriteSet, error) {
+	if pokemon.Form != "" {
+		if sprites, err := formSprites(conf, pokemon.Form); err == nil {
+			return sprites, nil
+		}
+	}
+	return pokemonSprites(pokemon, "")
+}
+
+// loadSprite downloads the sprite at url and draws it in mode.
+func loadSprite(conf *globals.Config, url, mode string) ([]string, error) {
+	data, err := api.GetSprite(url, conf)
+	if err != nil {
+		return nil, i18n.Errorf("could not download sprite - %w", err)
+	}
+	img, err := sprite.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	return sprite.Render(img, sprite.Detect(mode, conf.Theme.Enabled()), maxSpriteWidth), nil
+}
+
+type spriteResult struct {
+	lines   []string
+	Pokemon string `json:"pokemon"`
+	Gen     string `json:"gen,omitempty"`
+	Form    string `json:"form,omitempty"`
+	Shiny   bool   `json:"shiny"`
+	Back    bool   `json:"back"`
+	URL     string `json:"url"`
+}
+
+func (r spriteResult) renderText(w io.Writer, th theme.Theme) {
+	defer fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, ".\n.")
+	for _, line := range r.lines {
+		fmt.Fprintln(w, line)
+	}
+}
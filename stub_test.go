@@ -0,0 +1,224 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/acehotel33/pokedex-cli/globals"
+)
+
+var stubAreas = []string{"canalave-city-area", "eterna-city-area", "pastoria-city-area"}
+
+// newStubServer serves a tiny fake PokeAPI with the stubAreas location areas,
+// two explorable areas in the sinnoh region, and a pokemon for any name.
+// Each resource has a handler of its own below.
+func newStubServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/location-area/", stubLocationArea)
+	mux.HandleFunc("/location/", stubLocation)
+	mux.HandleFunc("/region/", stubRegion)
+	mux.HandleFunc("/item/", stubItem)
+	mux.HandleFunc("/pokemon-species/", stubSpecies)
+	mux.HandleFunc("/evolution-chain/4/", stubEvolutionChain)
+	mux.HandleFunc("/pokemon/", stubPokemon)
+	mux.HandleFunc("/sprites/", stubSprite)
+	mux.HandleFunc("/pokemon-form/", stubForm)
+	mux.HandleFunc("/cries/", stubCry)
+	mux.HandleFunc("/move/", stubMove)
+	mux.HandleFunc("/ability/", stubAbility)
+	mux.HandleFunc("/type/", stubType)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// stubURL returns the URL of the stub server, which responses link back to.
+func stubURL(r *http.Request) string {
+	return "http://" + r.Host
+}
+
+func stubLocationArea(w http.ResponseWriter, r *http.Request) {
+	base := stubURL(r)
+	switch strings.TrimPrefix(r.URL.Path, "/location-area/") {
+	case "":
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		results := []globals.LocationArea{}
+		for i := offset; i < offset+limit && i < len(stubAreas); i++ {
+			results = append(results, globals.LocationArea{Name: stubAreas[i], URL: fmt.Sprintf("%s/location-area/%d/", base, i+1)})
+		}
+		json.NewEncoder(w).Encode(globals.LocationAreasAll{Count: len(stubAreas), Results: results})
+	case "canalave-city-area":
+		fmt.Fprintf(w, `{"name":"canalave-city-area","names":[{"language":{"name":"ja"},"name":"ミオシティ"}],"location":{"name":"canalave-city","url":"%s/location/canalave-city/"},`+
+			`"encounter_method_rates":[{"encounter_method":{"name":"surf"},"version_details":[{"rate":10,"version":{"name":"diamond"}},{"rate":20,"version":{"name":"platinum"}}]}],`+
+			`"pokemon_encounters":[`+
+			`{"pokemon":{"name":"tentacool"},"version_details":[{"version":{"name":"diamond"},"encounter_details":[{"chance":60,"min_level":20,"max_level":30,"method":{"name":"surf"}}]},{"version":{"name":"platinum"},"encounter_details":[{"chance":60,"min_level":20,"max_level":30,"method":{"name":"surf"}}]}]},`+
+			`{"pokemon":{"name":"staryu"},"version_details":[{"version":{"name":"platinum"},"encounter_details":[{"chance":40,"min_level":10,"max_level":20,"method":{"name":"good-rod"}}]}]}]}`, base)
+	case "route-201-area":
+		fmt.Fprintf(w, `{"name":"route-201-area","location":{"name":"route-201","url":"%[1]s/location/route-201/"},`+
+			`"encounter_method_rates":[{"encounter_method":{"name":"walk"},"version_details":[{"rate":100,"version":{"name":"diamond"}}]},{"encounter_method":{"name":"old-rod"},"version_details":[{"rate":0,"version":{"name":"diamond"}}]}],`+
+			`"pokemon_encounters":[{"pokemon":{"name":"starly"},"version_details":[{"version":{"name":"red","url":"%[1]s/version/1/"},"encounter_details":[{"chance":50,"min_level":40,"max_level":40,"method":{"name":"walk"}}]},`+
+			`{"version":{"name":"diamond","url":"%[1]s/version/12/"},"encounter_details":[{"chance":50,"min_level":2,"max_level":2,"method":{"name":"walk"}}]}]},`+
+			`{"pokemon":{"name":"pidgey"},"version_details":[{"version":{"name":"red","url":"%[1]s/version/1/"},"encounter_details":[{"chance":100,"min_level":40,"max_level":40,"method":{"name":"walk"}}]}]},`+
+			`{"pokemon":{"name":"magikarp"},"version_details":[{"version":{"name":"diamond"},"encounter_details":[{"chance":100,"min_level":5,"max_level":5,"method":{"name":"old-rod"}}]}]}]}`, base)
+	case "pastoria-city-area":
+		http.Error(w, "unavailable", http.StatusInternalServerError)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func stubLocation(w http.ResponseWriter, r *http.Request) {
+	switch strings.Trim(strings.TrimPrefix(r.URL.Path, "/location/"), "/") {
+	case "canalave-city":
+		fmt.Fprint(w, `{"name":"canalave-city","region":{"name":"sinnoh"},"areas":[{"name":"canalave-city-area"}]}`)
+	case "route-201":
+		fmt.Fprint(w, `{"name":"route-201","region":{"name":"sinnoh"},"areas":[{"name":"route-201-area"}]}`)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func stubRegion(w http.ResponseWriter, r *http.Request) {
+	switch strings.TrimPrefix(r.URL.Path, "/region/") {
+	case "":
+		fmt.Fprint(w, `{"count":2,"next":null,"previous":null,"results":[{"name":"kanto"},{"name":"sinnoh"}]}`)
+	case "sinnoh":
+		fmt.Fprint(w, `{"name":"sinnoh","locations":[{"name":"canalave-city"},{"name":"eterna-city"}]}`)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func stubItem(w http.ResponseWriter, r *http.Request) {
+	name := strings.Trim(strings.TrimPrefix(r.URL.Path, "/item/"), "/")
+	if !strings.HasSuffix(name, "-ball") {
+		http.NotFound(w, r)
+		return
+	}
+	display := strings.ToUpper(name[:1]) + strings.ReplaceAll(name[1:len(name)-5], "-", " ") + " Ball"
+	fmt.Fprintf(w, `{"name":"%s","names":[{"language":{"name":"en"},"name":"%s"},{"language":{"name":"ja"},"name":"%s-ja"}],"effect_entries":[{"language":{"name":"en"},"short_effect":"Tries to catch a wild Pokémon."}]}`, name, display, name)
+}
+
+func stubSpecies(w http.ResponseWriter, r *http.Request) {
+	base := stubURL(r)
+	name := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/pokemon-species/"), "/")
+	// Like darmanitan, charizard has no pokemon of its own name.
+	defaultVariety := name
+	if name == "charizard" {
+		defaultVariety = "charizard-standard"
+	}
+	fmt.Fprintf(w, `{"name":"%s","names":[{"language":{"name":"ja"},"name":"%s-ja"}],"capture_rate":190,"base_happiness":50,"gender_rate":4,`+
+		`"varieties":[{"is_default":true,"pokemon":{"name":"%s"}},{"is_default":false,"pokemon":{"name":"%s-alola"}}],"evolution_chain":{"url":"%s/evolution-chain/4/"},`+
+		`"habitat":{"name":"forest"},"color":{"name":"yellow"},"shape":{"name":"quadruped"},"generation":{"name":"generation-i"},`+
+		`"genera":[{"genus":"Mouse Pokémon","language":{"name":"en"}},{"genus":"Maus-Pokémon","language":{"name":"de"}}],`+
+		`"flavor_text_entries":[{"flavor_text":"When several of\nthese POKéMON\fgather, their\nelectricity could\nbuild and cause\nlightning storms.","language":{"name":"en"},"version":{"name":"red"}},`+
+		`{"flavor_text":"Es kann Elektrizität speichern.","language":{"name":"de"},"version":{"name":"x"}},`+
+		`{"flavor_text":"It keeps its tail\nraised to monitor\nits surroundings.","language":{"name":"en"},"version":{"name":"yellow"}}]}`, name, name, defaultVariety, name, base)
+}
+
+func stubEvolutionChain(w http.ResponseWriter, r *http.Request) {
+	fmt.Fprint(w, `{"id":4,"chain":{"species":{"name":"charmander"},"evolution_details":[],"evolves_to":[`+
+		`{"species":{"name":"charmeleon"},"evolution_details":[{"trigger":{"name":"level-up"},"min_level":16}],"evolves_to":[`+
+		`{"species":{"name":"charizard"},"evolution_details":[{"trigger":{"name":"level-up"},"min_level":36}],"evolves_to":[]}]},`+
+		`{"species":{"name":"charmeleon-traded"},"evolution_details":[{"trigger":{"name":"trade"},"held_item":{"name":"metal-coat"}}],"evolves_to":[]}]}}`)
+}
+
+func stubPokemon(w http.ResponseWriter, r *http.Request) {
+	base := stubURL(r)
+	if strings.HasSuffix(r.URL.Path, "/encounters") {
+		fmt.Fprint(w, `[{"location_area":{"name":"viridian-forest-area"},"version_details":[`+
+			`{"version":{"name":"red"},"encounter_details":[{"chance":5,"min_level":3,"max_level":5,"method":{"name":"walk"}},{"chance":3,"min_level":4,"max_level":4,"method":{"name":"walk"}}]},`+
+			`{"version":{"name":"yellow"},"encounter_details":[{"chance":10,"min_level":2,"max_level":6,"method":{"name":"walk"}}]}]},`+
+			`{"location_area":{"name":"power-plant-area"},"version_details":[`+
+			`{"version":{"name":"yellow"},"encounter_details":[{"chance":25,"min_level":20,"max_level":24,"method":{"name":"walk"}}]}]}]`)
+		return
+	}
+	name := strings.TrimPrefix(r.URL.Path, "/pokemon/")
+	if name == "charizard" {
+		http.NotFound(w, r)
+		return
+	}
+	fmt.Fprintf(w, `{"name":"%s","base_experience":112,"height":4,"weight":60,"species":{"name":"%s","url":"%s/pokemon-species/%s/"},"location_area_encounters":"%s/pokemon/25/encounters",`+
+		`"forms":[{"name":"%s"},{"name":"%s-b"}],"cries":{"latest":"%s/cries/latest/%s.ogg","legacy":null},"sprites":{"front_default":"%s/sprites/%s.png","front_shiny":"%s/sprites/shiny/%s.png","versions":{"generation-i":{"red-blue":{"front_default":"%s/sprites/red-blue/%s.png"}}}},`+
+		`"abilities":[{"is_hidden":true,"slot":3,"ability":{"name":"lightning-rod"}},{"is_hidden":false,"slot":1,"ability":{"name":"static"}}],`+
+		`"types":[{"slot":1,"type":{"name":"normal"}}],"stats":[{"base_stat":50,"stat":{"name":"hp"}},{"base_stat":50,"stat":{"name":"attack"}},{"base_stat":50,"stat":{"name":"defense"}},`+
+		`{"base_stat":50,"stat":{"name":"special-attack"}},{"base_stat":50,"stat":{"name":"special-defense"}},{"base_stat":50,"stat":{"name":"speed"}}],`+
+		`"moves":[{"move":{"name":"tackle"},"version_group_details":[{"level_learned_at":1,"move_learn_method":{"name":"level-up"},"version_group":{"name":"red-blue"}},{"level_learned_at":1,"move_learn_method":{"name":"level-up"},"version_group":{"name":"yellow"}}]},`+
+		`{"move":{"name":"growl"},"version_group_details":[{"level_learned_at":5,"move_learn_method":{"name":"level-up"},"version_group":{"name":"red-blue"}},{"level_learned_at":0,"move_learn_method":{"name":"machine"},"version_group":{"name":"yellow"}}]},`+
+		`{"move":{"name":"thunder-wave"},"version_group_details":[{"level_learned_at":1,"move_learn_method":{"name":"level-up"},"version_group":{"name":"yellow"}}]}]}`,
+		name, name, base, name, base, name, name, base, name, base, name, base, name, base, name)
+}
+
+func stubSprite(w http.ResponseWriter, r *http.Request) {
+	// A transparent 3x3 sprite with a light pixel above a dark one.
+	img := image.NewNRGBA(image.Rect(0, 0, 3, 3))
+	img.Set(1, 1, color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+	img.Set(1, 2, color.NRGBA{A: 255})
+	png.Encode(w, img)
+}
+
+func stubForm(w http.ResponseWriter, r *http.Request) {
+	base := stubURL(r)
+	name := strings.Trim(strings.TrimPrefix(r.URL.Path, "/pokemon-form/"), "/")
+	// A form the API no longer has.
+	if strings.HasSuffix(name, "-missing") {
+		http.NotFound(w, r)
+		return
+	}
+	fmt.Fprintf(w, `{"name":"%s","sprites":{"front_default":"%s/sprites/form/%s.png","front_shiny":"%s/sprites/form/shiny/%s.png"}}`,
+		name, base, name, base, name)
+}
+
+func stubCry(w http.ResponseWriter, r *http.Request) {
+	fmt.Fprintf(w, "OggS %s", strings.TrimSuffix(path.Base(r.URL.Path), ".ogg"))
+}
+
+func stubMove(w http.ResponseWriter, r *http.Request) {
+	switch strings.Trim(strings.TrimPrefix(r.URL.Path, "/move/"), "/") {
+	case "tackle":
+		fmt.Fprint(w, `{"name":"tackle","power":40,"accuracy":100,"pp":35,"type":{"name":"normal"},"damage_class":{"name":"physical"},`+
+			`"names":[{"language":{"name":"en"},"name":"Tackle"},{"language":{"name":"ja"},"name":"たいあたり"}],"effect_chance":null,"effect_entries":[{"language":{"name":"en"},"short_effect":"Inflicts regular damage with no additional effect."}]}`)
+	case "growl":
+		fmt.Fprint(w, `{"name":"growl","power":null,"accuracy":100,"pp":40,"type":{"name":"normal"},"damage_class":{"name":"status"}}`)
+	case "thunder-shock":
+		fmt.Fprint(w, `{"name":"thunder-shock","power":40,"accuracy":100,"pp":30,"type":{"name":"electric"},"damage_class":{"name":"special"},`+
+			`"effect_chance":10,"effect_entries":[{"language":{"name":"en"},"short_effect":"Has a $effect_chance% chance to paralyze the target."}]}`)
+	case "thunder-wave":
+		fmt.Fprint(w, `{"name":"thunder-wave","power":null,"accuracy":null,"pp":20,"type":{"name":"electric"},"damage_class":{"name":"status"},"meta":{"ailment":{"name":"paralysis"},"ailment_chance":0}}`)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func stubAbility(w http.ResponseWriter, r *http.Request) {
+	if strings.Trim(strings.TrimPrefix(r.URL.Path, "/ability/"), "/") != "static" {
+		http.NotFound(w, r)
+		return
+	}
+	fmt.Fprint(w, `{"name":"static","generation":{"name":"generation-iii"},"names":[{"language":{"name":"en"},"name":"Static"},{"language":{"name":"ja"},"name":"せいでんき"}],`+
+		`"effect_entries":[{"language":{"name":"de"},"short_effect":"Kann paralysieren."},{"language":{"name":"en"},"effect":"Whenever a move makes contact with this Pokémon, the move's user has a 30% chance of being paralyzed.","short_effect":"Has a 30% chance of paralyzing attacking Pokémon on contact."}],`+
+		`"pokemon":[{"is_hidden":false,"slot":1,"pokemon":{"name":"pikachu"}},{"is_hidden":true,"slot":3,"pokemon":{"name":"electrike"}}]}`)
+}
+
+func stubType(w http.ResponseWriter, r *http.Request) {
+	switch strings.Trim(strings.TrimPrefix(r.URL.Path, "/type/"), "/") {
+	case "normal":
+		fmt.Fprint(w, `{"name":"normal","names":[{"language":{"name":"ja"},"name":"ノーマル"}],"damage_relations":{"half_damage_to":[{"name":"rock"},{"name":"steel"}],"no_damage_to":[{"name":"ghost"}],"double_damage_from":[{"name":"fighting"}],"no_damage_from":[{"name":"ghost"}]}}`)
+	case "rock":
+		fmt.Fprint(w, `{"name":"rock","damage_relations":{"double_damage_to":[{"name":"fire"},{"name":"flying"}],"half_damage_to":[{"name":"fighting"}],"double_damage_from":[{"name":"fighting"},{"name":"water"}],"half_damage_from":[{"name":"normal"},{"name":"fire"}]}}`)
+	default:
+		http.NotFound(w, r)
+	}
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"math/rand"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/acehotel33/pokedex-cli/globals"
+	"github.com/acehotel33/pokedex-cli/internal/api"
+	"github.com/acehotel33/pokedex-cli/internal/i18n"
+	"github.com/acehotel33/pokedex-cli/internal/theme"
+)
+
+func commandWalk(conf *globals.Config, params []string) error {
+	fs := flag.NewFlagSet("walk", flag.ContinueOnError)
+	method := fs.String("method", "walk", "encounter method to search with, e.g. surf or old-rod")
+	if _, err := parseFlags(fs, params); err != nil {
+		return err
+	}
+
+	if conf.Position.Area == "" {
+		return i18n.Errorf("you are not in an area - use 'goto <area>' first")
+	}
+	area, err := api.GetArea(conf.Endpoint("location-area", conf.Position.Area), conf)
+	if err != nil {
+		return i18n.Errorf("could not walk through %s - %w", conf.Position.Area, err)
+	}
+
+	version := gameVersion(conf.Settings.Version)
+	if version == "" {
+		// Pokemon found in several games would be counted once per game,
+		// with the levels of every game mixed up, so only the latest game
+		// is walked through.
+		version = latestVersion(area, *method)
+	}
+	candidates := wildCandidates(area, *method, version)
+	if len(candidates) == 0 {
+		methods := encounterMethods(area, version)
+		if len(methods) == 0 {
+			return i18n.Errorf("there are no wild pokemon in %s", area.Name)
+		}
+		return i18n.Errorf("no pokemon can be found with %s here - try --method %s", *method, strings.Join(methods, ", "))
+	}
+
+	res := walkResult{msg: i18n.New(conf.Settings.Lang), Area: area.Name, Method: *method}
+	rate := 100
+	for _, methodRate := range summarizeMethodRates(area, version) {
+		if methodRate.Method == *method {
+			rate = methodRate.Rate
+		}
+	}
+	// Walking on leaves any earlier wild pokemon behind, whether or not a
+	// new one appears.
+	conf.Encounter = nil
+	if conf.Rand.Intn(100) < rate {
+		encounter := pickWildEncounter(candidates, conf.Rand)
+		encounter.Area = area.Name
+		encounter.Method = *method
+		conf.Encounter = &encounter
+		res.Encounter = conf.Encounter
+	}
+
+	if err := saveGame(conf); err != nil {
+		return err
+	}
+	return render(conf, res)
+}
+
+// wildCandidate is one way a pokemon can appear, weighted by its chance.
+type wildCandidate struct {
+	pokemon  string
+	chance   int
+	minLevel int
+	maxLevel int
+}
+
+func wildCandidates(area globals.Area, method, version string) []wildCandidate {
+	candidates := []wildCandidate{}
+	for _, encounter := range area.PokemonEncounters {
+		for _, versionDetail := range encounter.VersionDetails {
+			if version != "" && versionDetail.Version.Name != version {
+				continue
+			}
+			for _, detail := range versionDetail.EncounterDetails {
+				if detail.Method.Name != method || detail.Chance <= 0 {
+					continue
+				}
+				candidates = append(candidates, wildCandidate{
+					pokemon:  encounter.Pokemon.Name,
+					chance:   detail.Chance,
+					minLevel: detail.MinLevel,
+					maxLevel: detail.MaxLevel,
+				})
+			}
+		}
+	}
+	return candidates
+}
+
+// latestVersion returns the newest game version with encounters by method
+// in the area, going by the ids of the versions, or "" if there is none.
+func latestVersion(area globals.Area, method string) string {
+	latest, latestID := "", -1
+	for _, encounter := range area.PokemonEncounters {
+		for _, versionDetail := range encounter.VersionDetails {
+			found := false
+			for _, detail := range versionDetail.EncounterDetails {
+				found = found || detail.Method.Name == method
+			}
+			if id := resourceID(versionDetail.Version.URL); found && id >= latestID {
+				latest, latestID = versionDetail.Version.Name, id
+			}
+		}
+	}
+	return latest
+}
+
+// resourceID returns the id at the end of a PokeAPI resource URL, e.g. 12
+// for ".../version/12/", or 0 if there is none.
+func resourceID(url string) int {
+	id, _ := strconv.Atoi(path.Base(strings.TrimSuffix(url, "/")))
+	return id
+}
+
+// pickWildEncounter picks a candidate weighted by its chance and a level
+// within its level range.
+func pickWildEncounter(candidates []wildCandidate, r *rand.Rand) globals.WildEncounter {
+	total := 0
+	for _, candidate := range candidates {
+		total += candidate.chance
+	}
+
+	roll := r.Intn(total)
+	picked := candidates[len(candidates)-1]
+	for _, candidate := range candidates {
+		if roll < candidate.chance {
+			picked = candidate
+			break
+		}
+		roll -= candidate.chance
+	}
+
+	level := picked.minLevel
+	if picked.maxLevel > picked.minLevel {
+		level += r.Intn(picked.maxLevel - picked.minLevel + 1)
+	}
+	return globals.WildEncounter{Pokemon: picked.pokemon, Level: level}
+}
+
+func encounterMethods(area globals.Area, version string) []string {
+	seen := map[string]bool{}
+	for _, encounter := range area.PokemonEncounters {
+		for _, summary := range summarizeEncounters(encounter.VersionDetails, version) {
+			seen[summary.Method] = true
+		}
+	}
+	methods := make([]string, 0, len(seen))
+	for method := range seen {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+	return methods
+}
+
+type walkResult struct {
+	msg       i18n.Printer
+	Area      string                 `json:"area"`
+	Method    string                 `json:"method"`
+	Encounter *globals.WildEncounter `json:"encounter"`
+}
+
+func (r walkResult) renderText(w io.Writer, th theme.Theme) {
+	defer fmt.Fprintln(w, ".\n.")
+	fmt.Fprintln(w, ".\n.")
+	if r.Method == "walk" {
+		fmt.Fprintln(w, r.msg.Sprintf("You walk through %s...", r.Area))
+	} else {
+		fmt.Fprintln(w, r.msg.Sprintf("You search %s with %s...", r.Area, r.Method))
+	}
+	if r.Encounter == nil {
+		fmt.Fprintln(w, r.msg.Sprintf("Nothing appeared."))
+		return
+	}
+	fmt.Fprintln(w, r.msg.Sprintf("A wild %s (lv %d) appeared!", th.Heading(r.Encounter.Pokemon), r.Encounter.Level))
+}